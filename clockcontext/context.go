@@ -0,0 +1,105 @@
+package clockcontext
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.einride.tech/clock"
+)
+
+// WithDeadline returns a copy of the parent context that is cancelled when the clock passes the deadline,
+// when the returned cancel function is called, or when the parent context is done, whichever happens first.
+// Its Deadline method reports the deadline in the time of the clock, unless an enclosing context created by this
+// package has an earlier deadline, and its Err method returns context.DeadlineExceeded once the deadline has passed.
+// Contexts derived from it, such as by context.WithCancel, return context.DeadlineExceeded as well.
+//
+// Deadlines of the parent not set by this package, such as by context.WithDeadline, are in the time of the
+// system clock and are not reflected by the Deadline method, even though they still cancel the context.
+// Enclosing contexts created by this package are assumed to use the same clock.
+//
+// Canceling the context releases the resources associated with it, so code should call cancel
+// as soon as the operations running in the context complete.
+func WithDeadline(parent context.Context, c clock.Clock, deadline time.Time) (context.Context, context.CancelFunc) {
+	if p, ok := parent.Value(deadlineContextKey{}).(*deadlineContext); ok && p.deadline.Before(deadline) {
+		deadline = p.deadline
+	}
+	ctx := &deadlineContext{parent: parent, deadline: deadline, done: make(chan struct{})}
+	cancel := func() { ctx.cancel(context.Canceled) }
+	if err := parent.Err(); err != nil {
+		ctx.cancel(err)
+		return ctx, cancel
+	}
+	d := c.Until(deadline)
+	if d <= 0 {
+		ctx.cancel(context.DeadlineExceeded)
+		return ctx, cancel
+	}
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+	ctx.timer = c.AfterFunc(d, func() {
+		ctx.cancel(context.DeadlineExceeded)
+	})
+	ctx.stopParent = context.AfterFunc(parent, func() {
+		ctx.cancel(parent.Err())
+	})
+	return ctx, cancel
+}
+
+// WithTimeout returns WithDeadline(parent, c, c.Now().Add(timeout)).
+func WithTimeout(parent context.Context, c clock.Clock, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return WithDeadline(parent, c, c.Now().Add(timeout))
+}
+
+// deadlineContextKey is the key under which a deadlineContext returns itself from Value.
+type deadlineContextKey struct{}
+
+// deadlineContext is a cancellable context with a deadline in the time of a clock.
+type deadlineContext struct {
+	parent   context.Context
+	deadline time.Time
+	done     chan struct{}
+
+	mutex      sync.Mutex
+	err        error
+	timer      clock.Timer
+	stopParent func() bool
+}
+
+func (c *deadlineContext) Deadline() (time.Time, bool) {
+	return c.deadline, true
+}
+
+func (c *deadlineContext) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *deadlineContext) Err() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.err
+}
+
+func (c *deadlineContext) Value(key any) any {
+	if key == (deadlineContextKey{}) {
+		return c
+	}
+	return c.parent.Value(key)
+}
+
+// cancel closes the done channel with err, unless already done, and releases the timer and the parent.
+func (c *deadlineContext) cancel(err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.err != nil {
+		return
+	}
+	c.err = err
+	close(c.done)
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	if c.stopParent != nil {
+		c.stopParent()
+	}
+}
@@ -0,0 +1,121 @@
+package clockcontext_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/clockcontext"
+	"go.einride.tech/clock/externalclock"
+	"go.einride.tech/clock/systemclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestWithTimeout_ExternalClock(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	ctx, cancel := clockcontext.WithTimeout(context.Background(), externalClock, time.Second)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.Assert(t, ok)
+	assert.Equal(t, time.Unix(1, 0), deadline)
+	externalClock.Advance(999 * time.Millisecond)
+	assert.NilError(t, ctx.Err())
+	// when the clock passes the deadline
+	externalClock.Advance(time.Millisecond)
+	// then the context should be done
+	<-ctx.Done()
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+	assert.Equal(t, context.DeadlineExceeded, context.Cause(ctx))
+}
+
+func TestWithDeadline_ExternalClock_Cancel(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	ctx, cancel := clockcontext.WithDeadline(context.Background(), externalClock, time.Unix(1, 0))
+	cancel()
+	assert.Equal(t, context.Canceled, ctx.Err())
+	// then the deadline should not override the cancellation
+	externalClock.Advance(time.Second)
+	assert.Equal(t, context.Canceled, ctx.Err())
+	assert.Equal(t, 0, externalClock.NumberOfTriggers())
+}
+
+func TestWithDeadline_ExternalClock_ParentDone(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	parent, cancelParent := context.WithCancel(context.Background())
+	ctx, cancel := clockcontext.WithDeadline(parent, externalClock, time.Unix(1, 0))
+	defer cancel()
+	cancelParent()
+	<-ctx.Done()
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
+
+func TestWithDeadline_ExternalClock_Expired(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(10, 0))
+	ctx, cancel := clockcontext.WithDeadline(context.Background(), externalClock, time.Unix(1, 0))
+	defer cancel()
+	<-ctx.Done()
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+}
+
+func TestWithDeadline_ParentDeadline(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	parent, cancelParent := clockcontext.WithDeadline(context.Background(), externalClock, time.Unix(1, 0))
+	defer cancelParent()
+	ctx, cancel := clockcontext.WithDeadline(parent, externalClock, time.Unix(2, 0))
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+	assert.Equal(t, time.Unix(1, 0), deadline)
+	externalClock.Advance(time.Second)
+	<-ctx.Done()
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+}
+
+func TestWithDeadline_ExternalClock_Derived(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	ctx, cancel := clockcontext.WithDeadline(context.Background(), externalClock, time.Unix(1, 0))
+	defer cancel()
+	derived, cancelDerived := context.WithCancel(context.WithValue(ctx, contextKey{}, "value"))
+	defer cancelDerived()
+	deadline, _ := derived.Deadline()
+	assert.Equal(t, time.Unix(1, 0), deadline)
+	// when the clock passes the deadline
+	externalClock.Advance(time.Second)
+	// then the derived context should be done with the deadline exceeded
+	<-derived.Done()
+	assert.Equal(t, context.DeadlineExceeded, derived.Err())
+	assert.Equal(t, context.DeadlineExceeded, context.Cause(derived))
+	assert.Equal(t, "value", derived.Value(contextKey{}))
+}
+
+func TestWithDeadline_SystemClockParentDeadline(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelParent()
+	// when the deadline in the time of the clock is after the deadline of the parent on the system clock
+	ctxDeadline := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx, cancel := clockcontext.WithDeadline(parent, externalClock, ctxDeadline)
+	defer cancel()
+	// then the deadlines should not be compared
+	deadline, _ := ctx.Deadline()
+	assert.Equal(t, ctxDeadline, deadline)
+	// when the parent is cancelled
+	cancelParent()
+	// then the context should be done
+	<-ctx.Done()
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
+
+type contextKey struct{}
+
+func TestWithTimeout_SystemClock(t *testing.T) {
+	systemClock := systemclock.New()
+	start := systemClock.Now()
+	ctx, cancel := clockcontext.WithTimeout(context.Background(), systemClock, 10*time.Millisecond)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.Assert(t, ok)
+	assert.Assert(t, !deadline.Before(start.Add(10*time.Millisecond)))
+	<-ctx.Done()
+	assert.Assert(t, !systemClock.Now().Before(deadline))
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+}
@@ -0,0 +1,6 @@
+// Package clockcontext provides contexts with deadlines tracked by a clock.Clock.
+//
+// Unlike context.WithDeadline and context.WithTimeout, which always use the system clock,
+// the contexts of this package are cancelled when the given clock passes their deadline,
+// so deadlines fire when expected in simulations driven by an external clock.
+package clockcontext
@@ -0,0 +1,61 @@
+// Package clockgrpc provides gRPC server interceptors attaching a clock.Clock to the context of each request.
+package clockgrpc
+
+import (
+	"context"
+
+	"go.einride.tech/clock"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor returns an interceptor that attaches the clock c to the context of each unary call,
+// for retrieval with clock.FromContext.
+func UnaryServerInterceptor(c clock.Clock) grpc.UnaryServerInterceptor {
+	return UnaryServerInterceptorWithSelector(fixed(c))
+}
+
+// UnaryServerInterceptorWithSelector returns an interceptor that attaches the clock returned by selector
+// for each unary call to its context, for retrieval with clock.FromContext. A nil clock leaves the context unchanged.
+func UnaryServerInterceptorWithSelector(selector func(context.Context) clock.Clock) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withClock(ctx, selector), req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that attaches the clock c to the context of each streaming call,
+// for retrieval with clock.FromContext.
+func StreamServerInterceptor(c clock.Clock) grpc.StreamServerInterceptor {
+	return StreamServerInterceptorWithSelector(fixed(c))
+}
+
+// StreamServerInterceptorWithSelector returns an interceptor that attaches the clock returned by selector
+// for each streaming call to its context, for retrieval with clock.FromContext.
+// A nil clock leaves the context unchanged.
+func StreamServerInterceptorWithSelector(selector func(context.Context) clock.Clock) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: withClock(ss.Context(), selector)})
+	}
+}
+
+func fixed(c clock.Clock) func(context.Context) clock.Clock {
+	return func(context.Context) clock.Clock {
+		return c
+	}
+}
+
+func withClock(ctx context.Context, selector func(context.Context) clock.Clock) context.Context {
+	if c := selector(ctx); c != nil {
+		return clock.WithClock(ctx, c)
+	}
+	return ctx
+}
+
+// serverStream is a grpc.ServerStream with a replaced context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,80 @@
+package clockgrpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/clockgrpc"
+	"go.einride.tech/clock/externalclock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gotest.tools/v3/assert"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(10, 0))
+	interceptor := clockgrpc.UnaryServerInterceptor(externalClock)
+	response, err := interceptor(
+		context.Background(),
+		"request",
+		&grpc.UnaryServerInfo{},
+		func(ctx context.Context, _ any) (any, error) {
+			return clock.FromContext(ctx).Now(), nil
+		},
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, time.Unix(10, 0), response)
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(10, 0))
+	interceptor := clockgrpc.StreamServerInterceptor(externalClock)
+	var got time.Time
+	err := interceptor(
+		nil,
+		&serverStream{ctx: context.Background()},
+		&grpc.StreamServerInfo{},
+		func(_ any, ss grpc.ServerStream) error {
+			got = clock.FromContext(ss.Context()).Now()
+			return nil
+		},
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, time.Unix(10, 0), got)
+}
+
+func TestUnaryServerInterceptorWithSelector(t *testing.T) {
+	clocks := map[string]clock.Clock{
+		"a": externalclock.New(time.Unix(1, 0)),
+		"b": externalclock.New(time.Unix(2, 0)),
+	}
+	interceptor := clockgrpc.UnaryServerInterceptorWithSelector(func(ctx context.Context) clock.Clock {
+		if values := metadata.ValueFromIncomingContext(ctx, "x-simulation"); len(values) > 0 {
+			return clocks[values[0]]
+		}
+		return nil
+	})
+	handler := func(ctx context.Context, _ any) (any, error) {
+		return clock.FromContext(ctx), nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-simulation", "b"))
+	response, err := interceptor(ctx, "request", &grpc.UnaryServerInfo{}, handler)
+	assert.NilError(t, err)
+	assert.Equal(t, clocks["b"], response)
+	// a nil clock should leave the context unchanged
+	ctx = clock.WithClock(context.Background(), clocks["a"])
+	response, err = interceptor(ctx, "request", &grpc.UnaryServerInfo{}, handler)
+	assert.NilError(t, err)
+	assert.Equal(t, clocks["a"], response)
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
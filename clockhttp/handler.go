@@ -0,0 +1,27 @@
+// Package clockhttp provides HTTP middleware attaching a clock.Clock to the context of each request.
+package clockhttp
+
+import (
+	"net/http"
+
+	"go.einride.tech/clock"
+)
+
+// Handler returns a handler that attaches the clock c to the context of each request,
+// for retrieval with clock.FromContext, before calling h.
+func Handler(h http.Handler, c clock.Clock) http.Handler {
+	return HandlerWithSelector(h, func(*http.Request) clock.Clock {
+		return c
+	})
+}
+
+// HandlerWithSelector returns a handler that attaches the clock returned by selector for each request
+// to its context, for retrieval with clock.FromContext, before calling h. A nil clock leaves the request unchanged.
+func HandlerWithSelector(h http.Handler, selector func(*http.Request) clock.Clock) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c := selector(r); c != nil {
+			r = r.WithContext(clock.WithClock(r.Context(), c))
+		}
+		h.ServeHTTP(w, r)
+	})
+}
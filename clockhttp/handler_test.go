@@ -0,0 +1,40 @@
+package clockhttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/clockhttp"
+	"go.einride.tech/clock/externalclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestHandler(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(10, 0))
+	var got time.Time
+	handler := clockhttp.Handler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = clock.FromContext(r.Context()).Now()
+	}), externalClock)
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, time.Unix(10, 0), got)
+}
+
+func TestHandlerWithSelector(t *testing.T) {
+	clocks := map[string]clock.Clock{
+		"a": externalclock.New(time.Unix(1, 0)),
+		"b": externalclock.New(time.Unix(2, 0)),
+	}
+	var got time.Time
+	handler := clockhttp.HandlerWithSelector(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = clock.FromContext(r.Context()).Now()
+	}), func(r *http.Request) clock.Clock {
+		return clocks[r.Header.Get("X-Simulation")]
+	})
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set("X-Simulation", "b")
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+	assert.Equal(t, time.Unix(2, 0), got)
+}
@@ -0,0 +1,8 @@
+// Package clockstream drives an externalclock.Clock from a stream of timestamps,
+// like the /clock topic publishing simulated time in ROS.
+//
+// The timestamps are applied to the clock in the order received. When no timestamp is received
+// within the configured stall timeout of wall-clock time, the stall is reported and, depending on the
+// StallPolicy, following the stream fails or the clock falls back to advancing with wall-clock time
+// until timestamps are received again.
+package clockstream
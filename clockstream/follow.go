@@ -0,0 +1,141 @@
+package clockstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/externalclock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrStalled is returned when no timestamp is received within the stall timeout with StallError.
+var ErrStalled = errors.New("clock stream stalled")
+
+// Stall describes a stall of the stream.
+type Stall struct {
+	// Last is the last timestamp received, or the time of the clock when following started
+	// if none has been received.
+	Last time.Time
+	// Duration is the wall-clock time since the last timestamp was received, or since following started.
+	Duration time.Duration
+}
+
+// Follow sets the time of the clock to each timestamp of the sequence in order, until the sequence ends,
+// which returns nil, the context is done, which returns the context error, or an invalid timestamp
+// or a stall with StallError is encountered.
+//
+// The sequence is iterated in its own goroutine, which exits once the sequence yields after Follow has returned.
+func Follow(ctx context.Context, c *externalclock.Clock, seq iter.Seq[*timestamppb.Timestamp], opts ...Option) error {
+	ch := make(chan *timestamppb.Timestamp)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(ch)
+		for timestamp := range seq {
+			select {
+			case ch <- timestamp:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return FollowChannel(ctx, c, ch, opts...)
+}
+
+// FollowChannel is like Follow, but receives the timestamps from a channel, until it is closed.
+func FollowChannel(
+	ctx context.Context,
+	c *externalclock.Clock,
+	ch <-chan *timestamppb.Timestamp,
+	opts ...Option,
+) error {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(&o)
+	}
+	f := follower{clock: c, options: o, last: c.Now(), lastWall: o.wallClock.Now()}
+	defer f.stopFallback()
+	var stalled <-chan time.Time
+	if o.stallTimeout > 0 {
+		stallTimer := o.wallClock.NewTimer(o.stallTimeout)
+		defer stallTimer.Stop()
+		f.stallTimer = stallTimer
+		stalled = stallTimer.C()
+	}
+	for {
+		var fallback <-chan time.Time
+		if f.fallbackTicker != nil {
+			fallback = f.fallbackTicker.C()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case timestamp, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := f.receive(timestamp); err != nil {
+				return err
+			}
+		case <-stalled:
+			if err := f.stall(); err != nil {
+				return err
+			}
+		case <-fallback:
+			c.SetTimestamp(f.last.Add(o.wallClock.Since(f.lastWall)))
+		}
+	}
+}
+
+// follower is the state of FollowChannel.
+type follower struct {
+	clock          *externalclock.Clock
+	options        options
+	last           time.Time
+	lastWall       time.Time
+	stallTimer     clock.Timer
+	fallbackTicker clock.Ticker
+}
+
+func (f *follower) receive(timestamp *timestamppb.Timestamp) error {
+	if err := timestamp.CheckValid(); err != nil {
+		return fmt.Errorf("follow clock stream: %w", err)
+	}
+	f.stopFallback()
+	f.last = timestamp.AsTime()
+	f.lastWall = f.options.wallClock.Now()
+	// Restart the stall timeout before setting the timestamp, which may block on delivery.
+	if f.stallTimer != nil {
+		f.stallTimer.Reset(f.options.stallTimeout)
+	}
+	f.clock.SetTimestamp(f.last)
+	return nil
+}
+
+func (f *follower) stall() error {
+	stall := Stall{Last: f.last, Duration: f.options.wallClock.Since(f.lastWall)}
+	if f.options.onStall != nil {
+		f.options.onStall(stall)
+	}
+	switch f.options.stallPolicy {
+	case StallError:
+		return fmt.Errorf("follow clock stream: no timestamp for %v since %v: %w", stall.Duration, stall.Last, ErrStalled)
+	case StallFallback:
+		if f.fallbackTicker == nil {
+			f.fallbackTicker = f.options.wallClock.NewTicker(f.options.fallbackInterval)
+		}
+	}
+	f.stallTimer.Reset(f.options.stallTimeout)
+	return nil
+}
+
+func (f *follower) stopFallback() {
+	if f.fallbackTicker != nil {
+		f.fallbackTicker.Stop()
+		f.fallbackTicker = nil
+	}
+}
@@ -0,0 +1,122 @@
+package clockstream_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/clockstream"
+	"go.einride.tech/clock/externalclock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+)
+
+func TestFollow(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	timer := externalClock.NewTimer(1500 * time.Millisecond)
+	timestamps := []*timestamppb.Timestamp{
+		timestamppb.New(time.Unix(1, 0)),
+		timestamppb.New(time.Unix(2, 0)),
+		timestamppb.New(time.Unix(3, 0)),
+	}
+	err := clockstream.Follow(context.Background(), externalClock, slices.Values(timestamps))
+	assert.NilError(t, err)
+	assert.Equal(t, time.Unix(3, 0).UTC(), externalClock.Now().UTC())
+	// then timers should have fired at the timestamp reaching their deadline
+	assert.Equal(t, time.Unix(2, 0).UTC(), (<-timer.C()).UTC())
+}
+
+func TestFollow_InvalidTimestamp(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	timestamps := []*timestamppb.Timestamp{timestamppb.New(time.Unix(1, 0)), nil}
+	err := clockstream.Follow(context.Background(), externalClock, slices.Values(timestamps))
+	assert.ErrorContains(t, err, "invalid nil Timestamp")
+	assert.Equal(t, time.Unix(1, 0).UTC(), externalClock.Now().UTC())
+}
+
+func TestFollowChannel_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := clockstream.FollowChannel(ctx, externalclock.New(time.Unix(0, 0)), make(chan *timestamppb.Timestamp))
+	assert.Assert(t, errors.Is(err, context.Canceled))
+}
+
+func TestFollowChannel_StallError(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	wallClock := externalclock.New(time.Unix(1000, 0))
+	ch := make(chan *timestamppb.Timestamp)
+	stalls := make(chan clockstream.Stall, 1)
+	errs := make(chan error)
+	go func() {
+		errs <- clockstream.FollowChannel(
+			context.Background(),
+			externalClock,
+			ch,
+			clockstream.WithWallClock(wallClock),
+			clockstream.WithStallTimeout(time.Second),
+			clockstream.WithStallPolicy(clockstream.StallError),
+			clockstream.WithOnStall(func(stall clockstream.Stall) {
+				stalls <- stall
+			}),
+		)
+	}()
+	received := externalClock.NewTimer(5 * time.Second)
+	ch <- timestamppb.New(time.Unix(5, 0))
+	receiveTimer(t, received)
+	// when no timestamp is received within the stall timeout
+	wallClock.Advance(time.Second)
+	// then the stall should be reported and following should fail
+	err := <-errs
+	assert.Assert(t, errors.Is(err, clockstream.ErrStalled), err)
+	assert.DeepEqual(t, clockstream.Stall{Last: time.Unix(5, 0).UTC(), Duration: time.Second}, <-stalls)
+}
+
+func TestFollowChannel_StallFallback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	externalClock := externalclock.New(time.Unix(0, 0))
+	wallClock := externalclock.New(time.Unix(1000, 0))
+	ch := make(chan *timestamppb.Timestamp)
+	errs := make(chan error)
+	go func() {
+		errs <- clockstream.FollowChannel(
+			ctx,
+			externalClock,
+			ch,
+			clockstream.WithWallClock(wallClock),
+			clockstream.WithStallTimeout(time.Second),
+			clockstream.WithStallPolicy(clockstream.StallFallback),
+			clockstream.WithFallbackInterval(100*time.Millisecond),
+		)
+	}()
+	received := externalClock.NewTimer(5 * time.Second)
+	ch <- timestamppb.New(time.Unix(5, 0))
+	receiveTimer(t, received)
+	wallClock.Advance(time.Second)
+	// when stalled, the clock should advance with the wall clock from the last timestamp
+	assert.NilError(t, wallClock.BlockUntil(ctx, 2))
+	advanced := externalClock.NewTimer(1100 * time.Millisecond)
+	wallClock.Advance(100 * time.Millisecond)
+	receiveTimer(t, advanced)
+	assert.Equal(t, time.Unix(6, 100_000_000).UTC(), externalClock.Now().UTC())
+	// then following should resume when timestamps are received again
+	ch <- timestamppb.New(time.Unix(7, 0))
+	ch <- timestamppb.New(time.Unix(8, 0))
+	close(ch)
+	assert.NilError(t, <-errs)
+	assert.Equal(t, time.Unix(8, 0).UTC(), externalClock.Now().UTC())
+	assert.Equal(t, 0, wallClock.NumberOfTriggers())
+}
+
+// receiveTimer waits for the timer to fire, failing the test if it does not within a second of real time.
+func receiveTimer(t *testing.T, timer clock.Timer) {
+	t.Helper()
+	select {
+	case <-timer.C():
+	case <-time.After(time.Second):
+		t.Fatal("timer did not fire")
+	}
+}
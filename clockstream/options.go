@@ -0,0 +1,80 @@
+package clockstream
+
+import (
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/systemclock"
+)
+
+// Option configures Follow and FollowChannel.
+type Option func(*options)
+
+type options struct {
+	stallTimeout     time.Duration
+	stallPolicy      StallPolicy
+	onStall          func(Stall)
+	fallbackInterval time.Duration
+	wallClock        clock.Clock
+}
+
+func defaultOptions() options {
+	return options{
+		stallPolicy:      StallWait,
+		fallbackInterval: 10 * time.Millisecond,
+		wallClock:        systemclock.New(),
+	}
+}
+
+// StallPolicy determines what happens when no timestamp is received within the stall timeout.
+type StallPolicy int
+
+const (
+	// StallWait keeps waiting for timestamps, reporting the stall once every stall timeout.
+	// This is the default policy.
+	StallWait StallPolicy = iota
+	// StallError stops following the stream with an error wrapping ErrStalled.
+	StallError
+	// StallFallback advances the clock with wall-clock time from the last timestamp received,
+	// until timestamps are received again. Timestamps received after a fallback may be before the
+	// time of the clock, and are handled according to the BackwardsPolicy of the clock.
+	StallFallback
+)
+
+// WithStallTimeout sets the wall-clock time after which not receiving a timestamp is a stall.
+// By default stalls are not detected.
+func WithStallTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.stallTimeout = timeout
+	}
+}
+
+// WithStallPolicy sets the StallPolicy.
+func WithStallPolicy(policy StallPolicy) Option {
+	return func(o *options) {
+		o.stallPolicy = policy
+	}
+}
+
+// WithOnStall sets a func called with every stall detected, before applying the StallPolicy.
+func WithOnStall(f func(Stall)) Option {
+	return func(o *options) {
+		o.onStall = f
+	}
+}
+
+// WithFallbackInterval sets the wall-clock interval at which the clock is advanced by StallFallback.
+// The default interval is 10ms.
+func WithFallbackInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.fallbackInterval = interval
+	}
+}
+
+// WithWallClock sets the clock measuring wall-clock time for stall detection and fallback.
+// The default is the system clock.
+func WithWallClock(c clock.Clock) Option {
+	return func(o *options) {
+		o.wallClock = c
+	}
+}
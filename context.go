@@ -0,0 +1,26 @@
+package clock
+
+import (
+	"context"
+
+	"go.einride.tech/clock/internal/system"
+)
+
+type contextKey struct{}
+
+// systemClock is the clock returned by FromContext when the context carries no clock.
+var systemClock MonotonicClock = system.New()
+
+// WithClock returns a copy of ctx carrying the clock c.
+func WithClock(ctx context.Context, c Clock) context.Context {
+	return context.WithValue(ctx, contextKey{}, c)
+}
+
+// FromContext returns the clock carried by ctx, or the system clock if ctx carries no clock.
+// The system clock implements MonotonicClock.
+func FromContext(ctx context.Context) Clock {
+	if c, ok := ctx.Value(contextKey{}).(Clock); ok {
+		return c
+	}
+	return systemClock
+}
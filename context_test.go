@@ -0,0 +1,29 @@
+package clock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/externalclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestFromContext(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	ctx := clock.WithClock(context.Background(), externalClock)
+	assert.Equal(t, clock.Clock(externalClock), clock.FromContext(ctx))
+}
+
+func TestFromContext_Fallback(t *testing.T) {
+	systemClock := clock.FromContext(context.Background())
+	before := time.Now()
+	now := systemClock.Now()
+	assert.Assert(t, !now.Before(before))
+	timer := systemClock.NewTimer(time.Millisecond)
+	assert.Assert(t, !(<-timer.C()).Before(now))
+	monotonicClock, ok := systemClock.(clock.MonotonicClock)
+	assert.Assert(t, ok)
+	assert.Assert(t, monotonicClock.Monotonic() > 0)
+}
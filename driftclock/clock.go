@@ -0,0 +1,168 @@
+package driftclock
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/internal/schedule"
+)
+
+// wanderInterval is the time of the underlying clock between changes of the wandering frequency error.
+const wanderInterval = time.Second
+
+// checkpointInterval is the number of segments between checkpoints.
+const checkpointInterval = 4096
+
+// Clock is a clock that drifts, wanders and jitters relative to another clock.
+type Clock struct {
+	base      clock.Clock
+	options   options
+	start     time.Time
+	scheduler *schedule.Scheduler
+	schedule.Clock
+
+	mutex sync.Mutex
+	// current is the distortion of the index:th wanderInterval since start, generated on demand.
+	current segment
+	index   int
+	// wanderSource is the state of the wander stream after generating the current segment.
+	wanderSource *rand.PCG
+	wander       *rand.Rand
+	// checkpoints holds the state every checkpointInterval segments, for regenerating earlier segments.
+	checkpoints []checkpoint
+	jitter      *rand.Rand
+}
+
+// segment is the distortion during an interval of the underlying clock.
+type segment struct {
+	// offset is the offset from the underlying clock at the start of the segment.
+	offset time.Duration
+	// ppm is the frequency error during the segment.
+	ppm float64
+}
+
+// checkpoint is the state of the clock at the start of a segment.
+type checkpoint struct {
+	segment      segment
+	wanderSource rand.PCG
+}
+
+var _ clock.Clock = &Clock{}
+
+// New returns a new clock distorting the base clock, starting at the current time of the base clock.
+func New(base clock.Clock, opts ...Option) *Clock {
+	c := &Clock{base: base}
+	for _, opt := range opts {
+		opt(&c.options)
+	}
+	if !c.options.seeded {
+		c.options.seed = rand.Uint64()
+	}
+	// Use separate streams for wander and jitter, so that the wander only depends on
+	// the time of the underlying clock, regardless of how often the time is read.
+	c.wanderSource = rand.NewPCG(c.options.seed, 1)
+	c.wander = rand.New(c.wanderSource)
+	c.jitter = rand.New(rand.NewPCG(c.options.seed, 2))
+	c.start = base.Now()
+	c.current = segment{ppm: c.options.driftPPM}
+	c.checkpoints = []checkpoint{{segment: c.current, wanderSource: *c.wanderSource}}
+	c.scheduler = schedule.New(base, timeline{clock: c})
+	c.Clock = schedule.NewClock(c.scheduler, c.Now)
+	return c
+}
+
+// Seed returns the seed of the random number generator of the clock.
+// Log it to be able to reproduce a failing run with WithSeed.
+func (c *Clock) Seed() uint64 {
+	return c.options.seed
+}
+
+// distorted returns the time of the clock, without jitter, when the underlying clock is at baseNow,
+// and the frequency error at that time. The caller must hold the mutex.
+func (c *Clock) distorted(baseNow time.Time) (time.Time, float64) {
+	elapsed := baseNow.Sub(c.start)
+	k := 0
+	if elapsed > 0 {
+		k = int(elapsed / wanderInterval)
+	}
+	s := c.segment(k)
+	return baseNow.Add(s.offset + ppmOf(elapsed-time.Duration(k)*wanderInterval, s.ppm)), s.ppm
+}
+
+// segment returns the distortion of the k:th wanderInterval since start. Segments after the current one
+// are generated from it, and earlier segments are regenerated from the closest checkpoint before them.
+// The caller must hold the mutex.
+func (c *Clock) segment(k int) segment {
+	if k < c.index {
+		cp := c.checkpoints[k/checkpointInterval]
+		c.current = cp.segment
+		c.index = k / checkpointInterval * checkpointInterval
+		*c.wanderSource = cp.wanderSource
+	}
+	for c.index < k {
+		c.current = segment{
+			offset: c.current.offset + ppmOf(wanderInterval, c.current.ppm),
+			ppm:    c.current.ppm + c.wander.NormFloat64()*c.options.wanderPPM,
+		}
+		c.index++
+		if c.index == len(c.checkpoints)*checkpointInterval {
+			c.checkpoints = append(c.checkpoints, checkpoint{segment: c.current, wanderSource: *c.wanderSource})
+		}
+	}
+	return c.current
+}
+
+// ppmOf returns ppm parts per million of d.
+func ppmOf(d time.Duration, ppm float64) time.Duration {
+	return time.Duration(float64(d) * ppm / 1e6)
+}
+
+// Now returns the current time of the clock, including jitter.
+func (c *Clock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now, _ := c.distorted(c.base.Now())
+	if maxJitter := c.options.jitter; maxJitter > 0 {
+		now = now.Add(time.Duration(c.jitter.Int64N(2*int64(maxJitter)+1)) - maxJitter)
+	}
+	return now
+}
+
+// timeline is the timeline of the clock without jitter, on which timers and tickers are scheduled.
+type timeline struct {
+	clock *Clock
+}
+
+func (t timeline) Now() time.Time {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+	now, _ := t.clock.distorted(t.clock.base.Now())
+	return now
+}
+
+func (t timeline) BaseDelay(deadline time.Time) (time.Duration, bool) {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+	baseNow := t.clock.base.Now()
+	now, ppm := t.clock.distorted(baseNow)
+	remaining := deadline.Sub(now)
+	if remaining <= 0 {
+		return 0, true
+	}
+	// Extrapolate the delay from the current frequency error, but no further than the end of the current
+	// segment, so that the scheduler checks the deadline again as the frequency error changes.
+	elapsed := baseNow.Sub(t.clock.start)
+	k := time.Duration(0)
+	if elapsed > 0 {
+		k = elapsed / wanderInterval
+	}
+	toSegmentEnd := (k+1)*wanderInterval - elapsed
+	rate := 1 + ppm/1e6
+	if rate <= 0 {
+		return min(remaining, toSegmentEnd), true
+	}
+	return min(time.Duration(math.Ceil(float64(remaining)/rate)), toSegmentEnd), true
+}
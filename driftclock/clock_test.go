@@ -0,0 +1,141 @@
+package driftclock_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/driftclock"
+	"go.einride.tech/clock/externalclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestDriftClock_Drift(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	driftClock := driftclock.New(base, driftclock.WithDrift(100))
+	base.Advance(10 * time.Second)
+	assert.Equal(t, time.Unix(10, 0).Add(time.Millisecond), driftClock.Now())
+	assert.Assert(t, driftClock.NowProto().AsTime().Equal(time.Unix(10, 0).Add(time.Millisecond)))
+}
+
+func TestDriftClock_Reproducible(t *testing.T) {
+	run := func(seed uint64) []time.Time {
+		base := externalclock.New(time.Unix(0, 0))
+		driftClock := driftclock.New(
+			base,
+			driftclock.WithSeed(seed),
+			driftclock.WithDrift(50),
+			driftclock.WithWander(10),
+			driftclock.WithJitter(time.Millisecond),
+		)
+		var readings []time.Time
+		for i := 0; i < 20; i++ {
+			base.Advance(700 * time.Millisecond)
+			readings = append(readings, driftClock.Now())
+		}
+		return readings
+	}
+	// when running twice with the same seed
+	// then the readings should be identical
+	assert.DeepEqual(t, run(42), run(42))
+	assert.Assert(t, run(42)[19] != run(43)[19])
+}
+
+func TestDriftClock_Wander_IndependentOfReadings(t *testing.T) {
+	newClock := func() (*externalclock.Clock, *driftclock.Clock) {
+		base := externalclock.New(time.Unix(0, 0))
+		return base, driftclock.New(base, driftclock.WithSeed(1), driftclock.WithWander(10))
+	}
+	base1, clock1 := newClock()
+	base2, clock2 := newClock()
+	// when reading one clock more often than the other
+	for i := 0; i < 100; i++ {
+		base1.Advance(100 * time.Millisecond)
+		clock1.Now()
+	}
+	base2.Advance(10 * time.Second)
+	// then both should have wandered the same way
+	assert.Equal(t, clock1.Now(), clock2.Now())
+	assert.Assert(t, clock1.Now() != base1.Now())
+}
+
+func TestDriftClock_Wander_BackwardsBaseClock(t *testing.T) {
+	newClock := func() (*externalclock.Clock, *driftclock.Clock) {
+		base := externalclock.New(time.Unix(0, 0))
+		return base, driftclock.New(base, driftclock.WithSeed(1), driftclock.WithWander(10))
+	}
+	base1, clock1 := newClock()
+	base2, clock2 := newClock()
+	// when the base clock of one clock jumps ahead and back
+	base1.Advance(24 * time.Hour)
+	clock1.Now()
+	base1.SetTimestamp(time.Unix(5000, 5e8))
+	base2.SetTimestamp(time.Unix(5000, 5e8))
+	// then both should have wandered the same way
+	assert.Equal(t, clock1.Now(), clock2.Now())
+}
+
+func TestDriftClock_Jitter(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	driftClock := driftclock.New(base, driftclock.WithJitter(time.Millisecond))
+	for i := 0; i < 100; i++ {
+		offset := driftClock.Now().Sub(base.Now())
+		assert.Assert(t, offset >= -time.Millisecond && offset <= time.Millisecond, offset)
+	}
+}
+
+func TestDriftClock_NewTimer(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	// Given a clock running 10% fast
+	driftClock := driftclock.New(base, driftclock.WithDrift(100_000))
+	timer := driftClock.NewTimer(11 * time.Second)
+	base.Advance(9 * time.Second)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired early")
+	default:
+	}
+	// then the timer should fire when the drifting clock reaches the deadline
+	base.Advance(time.Second)
+	assert.Equal(t, time.Unix(11, 0), <-timer.C())
+}
+
+func TestDriftClock_NewTimer_Wander(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	// Given a clock whose frequency error wanders widely
+	driftClock := driftclock.New(base, driftclock.WithSeed(3), driftclock.WithWander(100))
+	deadline := driftClock.Now().Add(time.Hour)
+	timer := driftClock.NewTimer(time.Hour)
+	// when the underlying clock passes the deadline
+	base.Advance(2 * time.Hour)
+	// then the timer should fire when the wandering clock reaches the deadline, not later
+	fired := <-timer.C()
+	assert.Assert(t, !fired.Before(deadline), fired)
+	assert.Assert(t, fired.Sub(deadline) < time.Millisecond, fired.Sub(deadline))
+}
+
+func TestDriftClock_NewTicker_Wander(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	driftClock := driftclock.New(base, driftclock.WithSeed(7), driftclock.WithDrift(1000), driftclock.WithWander(1000))
+	ticker := driftClock.NewTicker(time.Second)
+	defer ticker.Stop()
+	start := driftClock.Now()
+	for i := 1; i <= 5; i++ {
+		var tick time.Time
+		for tick.IsZero() {
+			base.Advance(time.Millisecond)
+			select {
+			case tick = <-ticker.C():
+			default:
+			}
+		}
+		// then each tick should be delivered as soon as the distorted clock reaches it
+		elapsed := tick.Sub(start)
+		assert.Assert(t, elapsed >= time.Duration(i)*time.Second, elapsed)
+		assert.Assert(t, elapsed < time.Duration(i)*time.Second+2*time.Millisecond, elapsed)
+	}
+}
+
+func TestDriftClock_Seed(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	assert.Equal(t, uint64(3), driftclock.New(base, driftclock.WithSeed(3)).Seed())
+}
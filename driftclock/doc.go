@@ -0,0 +1,10 @@
+// Package driftclock provides a clock implementation that distorts another clock, for testing
+// the robustness of code against imperfect clocks.
+//
+// The distortion combines a constant frequency error (drift), a frequency error that changes randomly
+// over time (wander), and random noise added to every reading of the time (jitter). Timers and tickers
+// fire when the drifting and wandering clock reaches their deadlines.
+//
+// All randomness is drawn from a generator seeded with the seed of the clock, so that a run can be
+// reproduced by creating the clock with the same seed and advancing the underlying clock in the same way.
+package driftclock
@@ -0,0 +1,47 @@
+package driftclock
+
+import "time"
+
+// Option configures a Clock created by New.
+type Option func(*options)
+
+type options struct {
+	seed      uint64
+	seeded    bool
+	driftPPM  float64
+	wanderPPM float64
+	jitter    time.Duration
+}
+
+// WithSeed sets the seed of the random number generator of the clock.
+// Without a seed, the clock uses a random seed, which can be read with Seed.
+func WithSeed(seed uint64) Option {
+	return func(o *options) {
+		o.seed = seed
+		o.seeded = true
+	}
+}
+
+// WithDrift sets the constant frequency error of the clock, in parts per million.
+// A positive drift makes the clock run fast, and a negative drift makes it run slow.
+func WithDrift(ppm float64) Option {
+	return func(o *options) {
+		o.driftPPM = ppm
+	}
+}
+
+// WithWander makes the frequency error of the clock follow a random walk, changing every second
+// of the underlying clock by a normally distributed amount with the standard deviation in parts per million.
+func WithWander(ppm float64) Option {
+	return func(o *options) {
+		o.wanderPPM = ppm
+	}
+}
+
+// WithJitter adds noise uniformly distributed in [-maxJitter, maxJitter] to every reading of the time
+// by Now, NowProto, Since and Until. The jitter does not affect when timers and tickers fire.
+func WithJitter(maxJitter time.Duration) Option {
+	return func(o *options) {
+		o.jitter = maxJitter
+	}
+}
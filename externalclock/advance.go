@@ -0,0 +1,136 @@
+package externalclock
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Advance advances the clock by d. See AdvanceTo.
+func (g *Clock) Advance(d time.Duration) {
+	g.AdvanceTo(g.getTime().Add(d))
+}
+
+// AdvanceTo advances the clock to t, stepping through the deadline of every ticker and timer that
+// becomes due on the way, in order, including tickers and timers created by AfterFunc callbacks
+// while advancing.
+//
+// At each step, AdvanceTo returns to stepping only once all values have been delivered on, or
+// dropped from, the channels of the tickers and timers that were due, and all AfterFunc callbacks
+// that were started have returned. AfterFunc callbacks must therefore not call AdvanceTo themselves.
+//
+// Timestamps before the current time are handled according to the BackwardsPolicy of the clock.
+func (g *Clock) AdvanceTo(t time.Time) {
+	var lastStep time.Time
+	var hasStepped, callbacksRan bool
+	for {
+		next, ok := g.nextDeadline()
+		if !ok || next.After(t) {
+			break
+		}
+		step := g.getTime()
+		if next.After(step) {
+			step = next
+		}
+		if hasStepped && step.Equal(lastStep) && !callbacksRan {
+			// Nothing can have become due at this instant since the last step, as no callbacks ran.
+			break
+		}
+		started := g.callbacks.started.Load()
+		g.SetTimestamp(step)
+		g.callbacks.wait()
+		callbacksRan = g.callbacks.started.Load() != started
+		lastStep, hasStepped = step, true
+	}
+	g.SetTimestamp(t)
+	g.callbacks.wait()
+}
+
+// AdvanceToWaiting is like AdvanceTo, but calls wait before each step instead of waiting for AfterFunc
+// callbacks, so that wait can let every goroutine react to the previous step. See externalclocktest.AdvanceInBubble.
+func (g *Clock) AdvanceToWaiting(t time.Time, wait func()) {
+	var lastStep time.Time
+	var lastCreated uint64
+	hasStepped := false
+	for {
+		wait()
+		next, ok := g.nextDeadline()
+		if !ok || next.After(t) {
+			break
+		}
+		step := g.getTime()
+		if next.After(step) {
+			step = next
+		}
+		created := g.createdTickers()
+		if hasStepped && step.Equal(lastStep) && created == lastCreated {
+			// Nothing can have become due at this instant since the last step, as no tickers were created.
+			break
+		}
+		g.SetTimestamp(step)
+		lastStep, lastCreated, hasStepped = step, created, true
+	}
+	g.SetTimestamp(t)
+	wait()
+}
+
+// nextDeadline returns the earliest deadline of the pending tickers, if any.
+func (g *Clock) nextDeadline() (time.Time, bool) {
+	g.tickerMutex.RLock()
+	defer g.tickerMutex.RUnlock()
+	if len(g.tickers) == 0 {
+		return time.Time{}, false
+	}
+	return g.tickers[0].deadline, true
+}
+
+// createdTickers returns the number of tickers created by the clock.
+func (g *Clock) createdTickers() uint64 {
+	g.tickerMutex.RLock()
+	defer g.tickerMutex.RUnlock()
+	return g.nextTickerID
+}
+
+// callbackTracker keeps track of running AfterFunc callbacks.
+type callbackTracker struct {
+	started atomic.Uint64
+	mutex   sync.Mutex
+	running int
+	// idle is closed when the number of running callbacks drops to zero.
+	idle chan struct{}
+}
+
+// run calls f in its own goroutine.
+func (c *callbackTracker) run(f func()) {
+	c.started.Add(1)
+	c.mutex.Lock()
+	if c.running == 0 {
+		c.idle = make(chan struct{})
+	}
+	c.running++
+	c.mutex.Unlock()
+	go func() {
+		defer c.done()
+		f()
+	}()
+}
+
+func (c *callbackTracker) done() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.running--
+	if c.running == 0 {
+		close(c.idle)
+	}
+}
+
+// wait blocks until no callbacks are running.
+func (c *callbackTracker) wait() {
+	c.mutex.Lock()
+	idle := c.idle
+	running := c.running
+	c.mutex.Unlock()
+	if running > 0 {
+		<-idle
+	}
+}
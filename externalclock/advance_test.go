@@ -0,0 +1,67 @@
+package externalclock_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestExternalClock_Advance_AfterFunc(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	// Given a callback that reschedules itself every second
+	var calls []time.Time
+	var f func()
+	f = func() {
+		calls = append(calls, externalClock.Now())
+		externalClock.AfterFunc(time.Second, f)
+	}
+	externalClock.AfterFunc(time.Second, f)
+	// when advancing the clock by 3.5 seconds
+	externalClock.Advance(3500 * time.Millisecond)
+	// then the callback should have run at every intermediate deadline before Advance returned
+	assert.DeepEqual(t, []time.Time{time.Unix(1, 0), time.Unix(2, 0), time.Unix(3, 0)}, calls)
+	assert.Equal(t, time.UnixMilli(3500), externalClock.Now())
+}
+
+func TestExternalClock_AdvanceTo_ZeroDelayCallback(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	// Given a callback that schedules another callback without delay
+	var calls []time.Time
+	externalClock.AfterFunc(time.Second, func() {
+		calls = append(calls, externalClock.Now())
+		externalClock.AfterFunc(0, func() {
+			calls = append(calls, externalClock.Now())
+		})
+	})
+	// when advancing the clock past the first callback
+	externalClock.AdvanceTo(time.Unix(2, 0))
+	// then both callbacks should have run at the same instant
+	assert.DeepEqual(t, []time.Time{time.Unix(1, 0), time.Unix(1, 0)}, calls)
+}
+
+func TestExternalClock_Advance_Ticker(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0), externalclock.WithDeliveryPolicy(externalclock.DeliverBlock))
+	// Given a ticker with a receiver
+	loopTicker := externalClock.NewTicker(time.Second)
+	defer loopTicker.Stop()
+	received := make(chan time.Time, 10)
+	go func() {
+		for ts := range loopTicker.C() {
+			received <- ts
+		}
+	}()
+	// when advancing the clock by several periods at once
+	externalClock.Advance(5 * time.Second)
+	// then every period should have been delivered in order
+	for i := 1; i <= 5; i++ {
+		assert.Equal(t, time.Unix(int64(i), 0), receiveTick(t, received))
+	}
+}
+
+func TestExternalClock_Advance_NoPending(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0))
+	externalClock.Advance(time.Hour)
+	assert.Equal(t, time.Unix(3600, 0), externalClock.Now())
+}
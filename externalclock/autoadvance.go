@@ -0,0 +1,167 @@
+package externalclock
+
+import (
+	"sync"
+	"time"
+)
+
+// WithAutoAdvance makes the clock advance itself: whenever every goroutine started with Go is blocked
+// on the clock, in Participant.Sleep or Participant.Await, the clock advances to the next deadline of its
+// tickers and timers. This allows long scenarios to be simulated as fast as the code under test runs,
+// without a goroutine feeding time to the clock.
+//
+// Every goroutine that blocks on the clock must be started with Go, and must block on the clock using
+// the Sleep or Await methods of its Participant rather than by receiving from channels directly.
+// The clock may advance as soon as the goroutines started so far are blocked, so goroutines meant to
+// start at the same time should be started before any of them blocks, for example from another participant.
+func WithAutoAdvance() Option {
+	return func(o *options) {
+		o.autoAdvance = true
+	}
+}
+
+// Participant is a goroutine started with Go, which auto-advance waits for, see WithAutoAdvance.
+type Participant struct {
+	clock *Clock
+}
+
+// autoAdvancer keeps track of the goroutines participating in auto-advance.
+type autoAdvancer struct {
+	mutex sync.Mutex
+	// participants counts the goroutines started with Go that have not yet returned.
+	participants int
+	// waiting holds the participants in Await by the channel they are receiving from.
+	waiting   map[<-chan time.Time][]*waiter
+	advancing bool
+}
+
+// waiter is a participant in Await.
+type waiter struct {
+	// ready is signalled when a value may have been sent on the channel.
+	ready chan struct{}
+}
+
+// Go runs f in a new goroutine that participates in auto-advance, see WithAutoAdvance.
+func (g *Clock) Go(f func(p *Participant)) {
+	a := &g.autoAdvancer
+	a.mutex.Lock()
+	a.participants++
+	a.mutex.Unlock()
+	go func() {
+		defer func() {
+			a.mutex.Lock()
+			a.participants--
+			a.mutex.Unlock()
+			g.maybeAutoAdvance()
+		}()
+		f(&Participant{clock: g})
+	}()
+}
+
+// Sleep blocks until the clock has advanced by at least d, letting the clock auto-advance while waiting.
+func (p *Participant) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	p.Await(p.clock.newTickerInternal(callerLocation(2), nil, d, false).C())
+}
+
+// Await receives a value from c, which must be the channel of a ticker or timer of the clock,
+// letting the clock auto-advance while waiting.
+func (p *Participant) Await(c <-chan time.Time) time.Time {
+	g := p.clock
+	if !g.options.autoAdvance {
+		return <-c
+	}
+	a := &g.autoAdvancer
+	var w *waiter
+	a.mutex.Lock()
+	for {
+		// Receive while holding the mutex, so that the participant is never counted as blocked
+		// after having received a value.
+		select {
+		case ts := <-c:
+			if w != nil {
+				a.remove(c, w)
+			}
+			a.mutex.Unlock()
+			return ts
+		default:
+		}
+		if w == nil {
+			w = &waiter{ready: make(chan struct{}, 1)}
+			if a.waiting == nil {
+				a.waiting = map[<-chan time.Time][]*waiter{}
+			}
+			a.waiting[c] = append(a.waiting[c], w)
+		}
+		a.mutex.Unlock()
+		g.maybeAutoAdvance()
+		<-w.ready
+		a.mutex.Lock()
+	}
+}
+
+// remove removes a waiter receiving from c. The caller must hold the mutex.
+func (a *autoAdvancer) remove(c <-chan time.Time, w *waiter) {
+	waiters := a.waiting[c]
+	for i, other := range waiters {
+		if other == w {
+			a.waiting[c] = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(a.waiting[c]) == 0 {
+		delete(a.waiting, c)
+	}
+}
+
+// notify signals the participants receiving from c after a value has been sent on it.
+func (a *autoAdvancer) notify(c <-chan time.Time) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	for _, w := range a.waiting[c] {
+		select {
+		case w.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// blocked counts the participants in Await with no value to receive. The caller must hold the mutex.
+func (a *autoAdvancer) blocked() int {
+	var n int
+	for c, waiters := range a.waiting {
+		if len(c) == 0 {
+			n += len(waiters)
+		}
+	}
+	return n
+}
+
+// maybeAutoAdvance advances the clock to the next deadline for as long as all participants are blocked.
+func (g *Clock) maybeAutoAdvance() {
+	if !g.options.autoAdvance {
+		return
+	}
+	a := &g.autoAdvancer
+	for {
+		a.mutex.Lock()
+		if a.advancing || a.participants == 0 || a.blocked() < a.participants {
+			a.mutex.Unlock()
+			return
+		}
+		next, ok := g.nextDeadline()
+		if !ok {
+			// All participants are blocked forever.
+			a.mutex.Unlock()
+			return
+		}
+		a.advancing = true
+		a.mutex.Unlock()
+		g.AdvanceTo(next)
+		a.mutex.Lock()
+		a.advancing = false
+		a.mutex.Unlock()
+	}
+}
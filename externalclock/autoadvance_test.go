@@ -0,0 +1,151 @@
+package externalclock_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestExternalClock_AutoAdvance(t *testing.T) {
+	simulateWeek(t)
+}
+
+func TestExternalClock_AutoAdvance_Stress(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+	for _, procs := range []int{1, 8} {
+		runtime.GOMAXPROCS(procs)
+		for i := 0; i < 100; i++ {
+			simulateWeek(t)
+		}
+	}
+}
+
+// simulateWeek runs goroutines waiting on an auto-advancing clock for a simulated week.
+func simulateWeek(t *testing.T) {
+	t.Helper()
+	const week = 7 * 24 * time.Hour
+	start := time.Unix(0, 0)
+	externalClock := externalclock.New(start, externalclock.WithAutoAdvance())
+	var wg sync.WaitGroup
+	wg.Add(2)
+	// Hold the goroutines until both are started, so that the clock doesn't advance with only one of them.
+	started := make(chan struct{})
+	// Given a goroutine sleeping an hour at a time for a week
+	var hours int
+	externalClock.Go(func(p *externalclock.Participant) {
+		defer wg.Done()
+		<-started
+		for externalClock.Since(start) < week {
+			p.Sleep(time.Hour)
+			hours++
+		}
+	})
+	// and a goroutine ticking daily for a week
+	var days []time.Time
+	externalClock.Go(func(p *externalclock.Participant) {
+		defer wg.Done()
+		<-started
+		dailyTicker := externalClock.NewTicker(24 * time.Hour)
+		defer dailyTicker.Stop()
+		for len(days) < 7 {
+			days = append(days, p.Await(dailyTicker.C()))
+		}
+	})
+	close(started)
+	// when waiting for the goroutines to finish
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("simulation did not finish")
+	}
+	// then the clock should have advanced through the week on its own
+	assert.Equal(t, 7*24, hours)
+	assert.Equal(t, start.Add(week), externalClock.Now())
+	for i, day := range days {
+		assert.Equal(t, start.Add(time.Duration(i+1)*24*time.Hour), day)
+	}
+}
+
+func TestExternalClock_AutoAdvance_WaitsForRunningGoroutines(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0), externalclock.WithAutoAdvance())
+	release := make(chan struct{})
+	done := make(chan struct{})
+	// Given a goroutine that is busy
+	externalClock.Go(func(*externalclock.Participant) {
+		<-release
+	})
+	// and one sleeping
+	externalClock.Go(func(p *externalclock.Participant) {
+		p.Sleep(time.Second)
+		close(done)
+	})
+	assert.NilError(t, externalClock.BlockUntil(t.Context(), 1))
+	// then the clock should not advance while the busy goroutine runs
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, time.Unix(0, 0), externalClock.Now())
+	// until it returns
+	close(release)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("clock did not advance")
+	}
+	assert.Equal(t, time.Unix(1, 0), externalClock.Now())
+}
+
+func TestExternalClock_AutoAdvance_SimultaneousTimers(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		externalClock := externalclock.New(time.Unix(0, 0), externalclock.WithAutoAdvance())
+		release := make(chan struct{})
+		awaited := make(chan struct{})
+		// Given a goroutine awaiting two timers due at the same instant, and then doing other work
+		externalClock.Go(func(p *externalclock.Participant) {
+			first := externalClock.NewTimer(time.Second)
+			second := externalClock.NewTimer(time.Second)
+			p.Await(first.C())
+			p.Await(second.C())
+			close(awaited)
+			<-release
+		})
+		// and a goroutine sleeping
+		externalClock.Go(func(p *externalclock.Participant) {
+			p.Sleep(time.Hour)
+		})
+		<-awaited
+		// then the clock should not advance while the first goroutine is busy
+		time.Sleep(time.Millisecond)
+		assert.Equal(t, time.Unix(1, 0), externalClock.Now())
+		close(release)
+	}
+}
+
+func TestExternalClock_AutoAdvance_NonParticipant(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0), externalclock.WithAutoAdvance())
+	release := make(chan struct{})
+	defer close(release)
+	// Given a participant that is busy
+	externalClock.Go(func(*externalclock.Participant) {
+		<-release
+	})
+	// when a goroutine not started with Go sleeps
+	slept := make(chan struct{})
+	go func() {
+		defer close(slept)
+		externalClock.Sleep(time.Second)
+	}()
+	assert.NilError(t, externalClock.BlockUntil(t.Context(), 1))
+	// then the clock should not advance on its behalf
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, time.Unix(0, 0), externalClock.Now())
+	externalClock.Advance(time.Second)
+	<-slept
+}
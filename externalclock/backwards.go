@@ -0,0 +1,65 @@
+package externalclock
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTimeMovedBackwards is returned by TrySetTimestamp when the timestamp is before the current
+// time of a clock using BackwardsReject.
+var ErrTimeMovedBackwards = errors.New("time moved backwards")
+
+// BackwardsPolicy determines how a clock handles timestamps before its current time,
+// for example when the external time source restarts or loops.
+type BackwardsPolicy int
+
+const (
+	// BackwardsAllow moves the clock backwards. Pending tickers and timers keep their deadlines,
+	// so they do not fire until the time has caught up again. This is the default policy.
+	BackwardsAllow BackwardsPolicy = iota
+	// BackwardsReject leaves the clock unchanged. TrySetTimestamp returns ErrTimeMovedBackwards.
+	BackwardsReject
+	// BackwardsClamp leaves the clock unchanged, keeping it monotonic.
+	BackwardsClamp
+	// BackwardsRebase treats the timestamp as a reset of the time source: the clock moves backwards
+	// and the deadlines of pending tickers and timers move with it, so they keep their remaining durations.
+	BackwardsRebase
+)
+
+// WithBackwardsPolicy sets the BackwardsPolicy of the clock.
+func WithBackwardsPolicy(policy BackwardsPolicy) Option {
+	return func(o *options) {
+		o.backwardsPolicy = policy
+	}
+}
+
+// BackwardsJump describes a timestamp before the current time of a clock.
+type BackwardsJump struct {
+	// From is the time of the clock before the jump.
+	From time.Time
+	// To is the timestamp the clock was set to.
+	To time.Time
+	// Policy is the BackwardsPolicy that was applied.
+	Policy BackwardsPolicy
+}
+
+// OnBackwardsJump subscribes f to be called whenever the clock is set to a timestamp before its
+// current time, after the BackwardsPolicy of the clock has been applied.
+// The func is called synchronously by SetTimestamp, so it should return quickly.
+// Calling the returned func cancels the subscription.
+func (g *Clock) OnBackwardsJump(f func(BackwardsJump)) (cancel func()) {
+	return g.backwardsSubscribers.subscribe(f)
+}
+
+// rebaseTickers moves the deadlines of all pending tickers by delta.
+func (g *Clock) rebaseTickers(delta time.Duration) {
+	g.tickerMutex.RLock()
+	pending := make([]*ticker, len(g.tickers))
+	copy(pending, g.tickers)
+	g.tickerMutex.RUnlock()
+	for _, t := range pending {
+		t.mutex.Lock()
+		g.rescheduleTicker(t, t.deadline.Add(delta))
+		t.mutex.Unlock()
+	}
+}
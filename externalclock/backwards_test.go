@@ -0,0 +1,129 @@
+package externalclock_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/externalclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestExternalClock_BackwardsPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		policy        externalclock.BackwardsPolicy
+		expectedError error
+		expectedNow   time.Time
+		// expectedFire is the time at which the timer due at 10s before the jump should fire.
+		expectedFire time.Time
+	}{
+		{
+			name:         "allow",
+			policy:       externalclock.BackwardsAllow,
+			expectedNow:  time.Unix(2, 0),
+			expectedFire: time.Unix(10, 0),
+		},
+		{
+			name:          "reject",
+			policy:        externalclock.BackwardsReject,
+			expectedError: externalclock.ErrTimeMovedBackwards,
+			expectedNow:   time.Unix(5, 0),
+			expectedFire:  time.Unix(10, 0),
+		},
+		{
+			name:         "clamp",
+			policy:       externalclock.BackwardsClamp,
+			expectedNow:  time.Unix(5, 0),
+			expectedFire: time.Unix(10, 0),
+		},
+		{
+			name:         "rebase",
+			policy:       externalclock.BackwardsRebase,
+			expectedNow:  time.Unix(2, 0),
+			expectedFire: time.Unix(7, 0),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			externalClock := externalclock.New(time.Unix(0, 0), externalclock.WithBackwardsPolicy(tt.policy))
+			var jumps []externalclock.BackwardsJump
+			cancel := externalClock.OnBackwardsJump(func(jump externalclock.BackwardsJump) {
+				jumps = append(jumps, jump)
+			})
+			defer cancel()
+			// Given a timer due at 10s
+			timer := externalClock.NewTimer(10 * time.Second)
+			assert.NilError(t, externalClock.TrySetTimestamp(time.Unix(5, 0)))
+			// when the time moves backwards
+			err := externalClock.TrySetTimestamp(time.Unix(2, 0))
+			// then the policy should be applied
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Equal(t, tt.expectedNow, externalClock.Now())
+			assert.DeepEqual(t, []externalclock.BackwardsJump{
+				{From: time.Unix(5, 0), To: time.Unix(2, 0), Policy: tt.policy},
+			}, jumps)
+			// and the timer should fire at the expected time
+			externalClock.SetTimestamp(tt.expectedFire.Add(-time.Nanosecond))
+			select {
+			case <-timer.C():
+				t.Fatal("timer fired early")
+			default:
+			}
+			externalClock.SetTimestamp(tt.expectedFire)
+			assert.Equal(t, tt.expectedFire, receiveTick(t, timer.C()))
+		})
+	}
+}
+
+func TestExternalClock_OnBackwardsJump_Cancel(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(10, 0))
+	var calls int
+	cancel := externalClock.OnBackwardsJump(func(externalclock.BackwardsJump) {
+		calls++
+	})
+	externalClock.SetTimestamp(time.Unix(5, 0))
+	cancel()
+	externalClock.SetTimestamp(time.Unix(1, 0))
+	assert.Equal(t, 1, calls)
+}
+
+func TestExternalClock_OnChange(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(10, 0), externalclock.WithBackwardsPolicy(externalclock.BackwardsReject))
+	externalClock.AfterFunc(time.Second, func() {})
+	var changes []time.Time
+	cancel := externalClock.OnChange(func(t time.Time) {
+		changes = append(changes, t)
+	})
+	externalClock.SetTimestamp(time.Unix(10, 0))
+	externalClock.Advance(2 * time.Second)
+	// then a rejected timestamp should not be a change
+	externalClock.SetTimestamp(time.Unix(5, 0))
+	cancel()
+	externalClock.SetTimestamp(time.Unix(20, 0))
+	assert.DeepEqual(t, []time.Time{time.Unix(11, 0), time.Unix(12, 0)}, changes)
+}
+
+func TestExternalClock_Monotonic(t *testing.T) {
+	for _, policy := range []externalclock.BackwardsPolicy{
+		externalclock.BackwardsAllow,
+		externalclock.BackwardsReject,
+		externalclock.BackwardsClamp,
+		externalclock.BackwardsRebase,
+	} {
+		externalClock := externalclock.New(time.Unix(100, 0), externalclock.WithBackwardsPolicy(policy))
+		var monotonicClock clock.MonotonicClock = externalClock
+		externalClock.SetTimestamp(time.Unix(110, 0))
+		assert.Equal(t, 10*time.Second, monotonicClock.Monotonic())
+		// when the clock is rewound
+		externalClock.SetTimestamp(time.Unix(50, 0))
+		// then the monotonic reading should not decrease
+		assert.Equal(t, 10*time.Second, monotonicClock.Monotonic())
+		// and should keep counting forward steps from the new time
+		externalClock.SetTimestamp(time.Unix(115, 0))
+		want := 15 * time.Second
+		if policy != externalclock.BackwardsReject && policy != externalclock.BackwardsClamp {
+			want = 75 * time.Second
+		}
+		assert.Equal(t, want, monotonicClock.Monotonic())
+	}
+}
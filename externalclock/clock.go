@@ -2,12 +2,11 @@ package externalclock
 
 import (
 	"context"
-	"crypto/rand"
 	"fmt"
-	"log"
 	"log/slog"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.einride.tech/clock"
@@ -17,45 +16,114 @@ import (
 type Clock struct {
 	timeMutex   sync.Mutex
 	currentTime time.Time
+	// monotonic is the sum of the forward steps of the clock since it was created.
+	monotonic   time.Duration
 	tickerMutex sync.RWMutex
-	tickers     map[string]*ticker
+	tickers     tickerHeap
+	// tickersChanged is closed and replaced whenever a ticker is added to or removed from tickers.
+	tickersChanged chan struct{}
+	// nextTickerID is the ID of the next created ticker, in creation order.
+	nextTickerID uint64
+	options      options
+	delivered    atomic.Uint64
+	dropped      atomic.Uint64
+	callbacks    callbackTracker
+	autoAdvancer autoAdvancer
+
+	backwardsSubscribers subscribers[BackwardsJump]
+	changeSubscribers    subscribers[time.Time]
 }
 
-func New(initialTime time.Time) *Clock {
+var _ clock.MonotonicClock = &Clock{}
+
+func New(initialTime time.Time, opts ...Option) *Clock {
 	c := &Clock{
-		tickers: map[string]*ticker{},
+		options:        defaultOptions(),
+		tickersChanged: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(&c.options)
 	}
 	c.currentTime = initialTime
 	return c
 }
 
+// SetTimestamp sets the current time of the clock and fires the tickers and timers that are due.
+// Timestamps before the current time are handled according to the BackwardsPolicy of the clock.
 func (g *Clock) SetTimestamp(t time.Time) {
-	g.timeMutex.Lock()
-	g.currentTime = t
-	g.timeMutex.Unlock()
+	_ = g.TrySetTimestamp(t)
+}
+
+// OnChange subscribes f to be called with the new time whenever the time of the clock changes,
+// whether by SetTimestamp, AdvanceTo or auto-advance, before the tickers and timers that are due fire.
+// The func is called synchronously by SetTimestamp, so it should return quickly.
+// Calling the returned func cancels the subscription.
+func (g *Clock) OnChange(f func(time.Time)) (cancel func()) {
+	return g.changeSubscribers.subscribe(f)
+}
 
+// TrySetTimestamp is like SetTimestamp, but returns ErrTimeMovedBackwards if the clock
+// uses BackwardsReject and the timestamp is before the current time.
+func (g *Clock) TrySetTimestamp(t time.Time) error {
+	g.timeMutex.Lock()
+	previous := g.currentTime
+	if !t.Before(previous) {
+		g.currentTime = t
+		g.monotonic += t.Sub(previous)
+		g.timeMutex.Unlock()
+		if t.After(previous) {
+			g.changeSubscribers.notify(t)
+		}
+		g.signalTickers(t)
+		return nil
+	}
+	policy := g.options.backwardsPolicy
+	switch policy {
+	case BackwardsReject, BackwardsClamp:
+		g.timeMutex.Unlock()
+	case BackwardsRebase:
+		g.rebaseTickers(t.Sub(previous))
+		g.currentTime = t
+		g.timeMutex.Unlock()
+	default:
+		g.currentTime = t
+		g.timeMutex.Unlock()
+	}
+	slog.Debug(
+		"time moved backwards",
+		slog.Time("from", previous),
+		slog.Time("to", t),
+		slog.Int("policy", int(policy)),
+	)
+	g.backwardsSubscribers.notify(BackwardsJump{From: previous, To: t, Policy: policy})
+	switch policy {
+	case BackwardsReject:
+		return fmt.Errorf("set timestamp %v before %v: %w", t, previous, ErrTimeMovedBackwards)
+	case BackwardsClamp:
+		return nil
+	}
+	g.changeSubscribers.notify(t)
 	g.signalTickers(t)
+	return nil
 }
 
+// signalTickers fires the tickers due at t in order of their deadlines,
+// with tickers created first firing first when deadlines are equal.
 func (g *Clock) signalTickers(t time.Time) {
 	g.tickerMutex.RLock()
-	for _, tickerInstance := range g.tickers {
-		if !tickerInstance.IsDurationReached(t) {
-			continue
-		}
-		tickerInstance.SetLastTimestamp(t)
-		if !tickerInstance.isPeriodic {
-			g.tickerMutex.RUnlock()
-			tickerInstance.Stop()
-			g.tickerMutex.RLock()
-		}
-		select {
-		case tickerInstance.timeChan <- t:
-		case <-time.After(20 * time.Millisecond):
-			slog.Debug("ticker dropped message", slog.String("caller", tickerInstance.caller))
-		}
-	}
+	due := g.tickers.due(t)
 	g.tickerMutex.RUnlock()
+	for _, tickerInstance := range due {
+		tickerInstance.fire(t)
+	}
+}
+
+// Stats returns the number of values delivered and dropped by all tickers and timers of the clock.
+func (g *Clock) Stats() Stats {
+	return Stats{
+		Delivered: g.delivered.Load(),
+		Dropped:   g.dropped.Load(),
+	}
 }
 
 func (g *Clock) NumberOfTriggers() int {
@@ -64,6 +132,26 @@ func (g *Clock) NumberOfTriggers() int {
 	return len(g.tickers)
 }
 
+// BlockUntil blocks until at least n tickers, timers or sleeping goroutines are waiting on the clock,
+// or the context is done. This lets tests make sure that the code under test has called Sleep, After,
+// AfterFunc, NewTimer or NewTicker before advancing the clock.
+func (g *Clock) BlockUntil(ctx context.Context, n int) error {
+	for {
+		g.tickerMutex.RLock()
+		waiters := len(g.tickers)
+		changed := g.tickersChanged
+		g.tickerMutex.RUnlock()
+		if waiters >= n {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("block until %d waiters, got %d: %w", n, waiters, ctx.Err())
+		case <-changed:
+		}
+	}
+}
+
 // Deprecated: Calling Run is not necessary anymore. The method only blocks until
 // context is cancelled.
 func (g *Clock) Run(ctx context.Context) error {
@@ -78,23 +166,14 @@ func (g *Clock) getTime() time.Time {
 }
 
 func (g *Clock) After(duration time.Duration) <-chan time.Time {
-	_, file, no, ok := runtime.Caller(1)
-	var calledFrom string
-	if ok {
-		calledFrom = fmt.Sprintf("called from %s#%d\n", file, no)
-	}
-	tickerInstance := g.newTickerInternal(calledFrom, nil, duration, false)
+	tickerInstance := g.newTickerInternal(callerLocation(2), nil, duration, false)
 	return tickerInstance.C()
 }
 
+// AfterFunc waits until the clock has advanced by the duration and then calls f in its own goroutine.
 func (g *Clock) AfterFunc(d time.Duration, f func()) clock.Timer {
-	_, file, no, ok := runtime.Caller(1)
-	var calledFrom string
-	if ok {
-		calledFrom = fmt.Sprintf("called from %s#%d\n", file, no)
-	}
 	return &Timer{
-		Ticker: g.newTickerInternal(calledFrom, f, d, false),
+		ticker: g.newTickerInternal(callerLocation(2), f, d, false),
 	}
 }
 
@@ -102,6 +181,14 @@ func (g *Clock) Now() time.Time {
 	return g.getTime()
 }
 
+// Monotonic returns the sum of the durations by which the clock has moved forward since it was created.
+// Moving the clock backwards does not decrease it, so measurements of elapsed time survive rewinds.
+func (g *Clock) Monotonic() time.Duration {
+	g.timeMutex.Lock()
+	defer g.timeMutex.Unlock()
+	return g.monotonic
+}
+
 func (g *Clock) NowProto() *timestamppb.Timestamp {
 	return timestamppb.New(g.getTime())
 }
@@ -111,16 +198,31 @@ func (g *Clock) Since(t time.Time) time.Duration {
 	return now.Sub(t)
 }
 
+// Sleep blocks until the clock has advanced by at least d.
 func (g *Clock) Sleep(d time.Duration) {
-	<-g.After(d)
+	if d <= 0 {
+		return
+	}
+	<-g.newTickerInternal(callerLocation(2), nil, d, false).C()
+}
+
+func (g *Clock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		return nil
+	}
+	return g.newTickerInternal(callerLocation(2), nil, d, true).C()
+}
+
+func (g *Clock) Until(t time.Time) time.Duration {
+	now := g.getTime()
+	return t.Sub(now)
 }
 
-func makeUUID() string {
-	b := make([]byte, 16)
-	if _, err := rand.Read(b); err != nil {
-		log.Fatal(err)
+// callerLocation returns the file:line of the caller skip frames up the stack.
+func callerLocation(skip int) string {
+	_, file, no, ok := runtime.Caller(skip)
+	if !ok {
+		return ""
 	}
-	uuid := fmt.Sprintf("%x-%x-%x-%x-%x",
-		b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
-	return uuid
+	return fmt.Sprintf("%s:%d", file, no)
 }
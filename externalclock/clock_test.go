@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"go.einride.tech/clock"
 	"go.einride.tech/clock/externalclock"
 	"golang.org/x/sync/errgroup"
 	"gotest.tools/v3/assert"
@@ -139,17 +140,18 @@ func TestExternalClock_AfterFunc(t *testing.T) {
 	triggerTime := 1 * time.Millisecond
 
 	// then trigger func after trigger time
-	didSet := false
+	called := make(chan struct{})
 	afterTimer := externalClock.AfterFunc(triggerTime, func() {
-		didSet = true
+		close(called)
 	})
+	assert.Assert(t, afterTimer.C() == nil)
 	externalClock.SetTimestamp(time.Unix(0, triggerTime.Nanoseconds()+1))
 	select {
-	case <-time.After(2 * time.Millisecond):
+	case <-time.After(1 * time.Second):
 		t.FailNow()
-	case <-afterTimer.C():
+	case <-called:
 	}
-	assert.Assert(t, didSet)
+	assert.Equal(t, externalClock.NumberOfTriggers(), 0)
 }
 
 func TestExternalClock_Removed(t *testing.T) {
@@ -206,6 +208,47 @@ func TestExternalClock_NewTimer(t *testing.T) {
 	}
 }
 
+func TestExternalClock_NewTimer_Interface(t *testing.T) {
+	var c clock.Clock = newTestFixture(t)
+	timer := c.NewTimer(time.Millisecond)
+	c.(*externalclock.Clock).SetTimestamp(time.Unix(0, time.Millisecond.Nanoseconds()))
+	select {
+	case <-time.After(1 * time.Second):
+		t.FailNow()
+	case ts := <-timer.C():
+		assert.Equal(t, time.Unix(0, time.Millisecond.Nanoseconds()), ts)
+	}
+}
+
+func TestExternalClock_TickChannel(t *testing.T) {
+	externalClock := newTestFixture(t)
+	tickTime := 1 * time.Millisecond
+	tick := externalClock.Tick(tickTime)
+	assert.Equal(t, externalClock.NumberOfTriggers(), 1)
+	for i := range 10 {
+		externalClock.SetTimestamp(time.Unix(0, int64(i+1)*tickTime.Nanoseconds()))
+		select {
+		case <-time.After(1 * time.Second):
+			t.FailNow()
+		case <-tick:
+		}
+	}
+}
+
+func TestExternalClock_TickChannel_NonPositiveDuration(t *testing.T) {
+	externalClock := newTestFixture(t)
+	assert.Assert(t, externalClock.Tick(0) == nil)
+	assert.Assert(t, externalClock.Tick(-time.Second) == nil)
+	assert.Equal(t, externalClock.NumberOfTriggers(), 0)
+}
+
+func TestExternalClock_Until(t *testing.T) {
+	externalClock := newTestFixture(t)
+	externalClock.SetTimestamp(time.Unix(10, 0))
+	assert.Equal(t, externalClock.Until(time.Unix(15, 0)), 5*time.Second)
+	assert.Equal(t, externalClock.Until(time.Unix(5, 0)), -5*time.Second)
+}
+
 func TestExternalClock_NewTicker_Tick_Periodically(t *testing.T) {
 	externalClock := newTestFixture(t)
 	// Given a ticker with a tick time
@@ -229,6 +272,59 @@ func TestExternalClock_NewTicker_Tick_Periodically(t *testing.T) {
 	}
 }
 
+func TestExternalClock_FiringOrder(t *testing.T) {
+	externalClock := newTestFixture(t)
+	// Given tickers created with periods out of order, several of them equal
+	periods := []time.Duration{25, 21, 25, 29, 21, 23, 20, 29}
+	tickers := make([]clock.Ticker, len(periods))
+	for i, period := range periods {
+		tickers[i] = externalClock.NewTicker(period * time.Millisecond)
+	}
+	// the expected firing order is by deadline, then by creation
+	expected := []int{6, 1, 4, 5, 0, 2, 3, 7}
+	// and tickers whose channels are full from having ticked once
+	externalClock.SetTimestamp(time.UnixMilli(29))
+	// when all of them are due again at once
+	go externalClock.SetTimestamp(time.UnixMilli(58))
+	// then each ticker should be blocked delivering its second tick in the expected order.
+	// Delivery to a ticker out of order would time out and drop its tick.
+	for _, i := range expected {
+		assert.Equal(t, time.UnixMilli(29), receiveTick(t, tickers[i].C()))
+		assert.Equal(t, time.UnixMilli(58), receiveTick(t, tickers[i].C()), "ticker %d", i)
+	}
+}
+
+func TestExternalClock_BlockUntil(t *testing.T) {
+	externalClock := newTestFixture(t)
+	// Given goroutines sleeping on the clock
+	done := make(chan struct{}, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			externalClock.Sleep(time.Second)
+			done <- struct{}{}
+		}()
+	}
+	// when waiting for both of them to be blocked before advancing time
+	assert.NilError(t, externalClock.BlockUntil(context.Background(), 2))
+	externalClock.Advance(time.Second)
+	// then both should wake up
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("sleeping goroutine did not wake up")
+		}
+	}
+	assert.Equal(t, externalClock.NumberOfTriggers(), 0)
+}
+
+func TestExternalClock_BlockUntil_ContextDone(t *testing.T) {
+	externalClock := newTestFixture(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	t.Cleanup(cancel)
+	assert.ErrorIs(t, externalClock.BlockUntil(ctx, 1), context.DeadlineExceeded)
+}
+
 func TestExternalClock_SendBeforeRun(t *testing.T) {
 	// test verifies that sending time on an unstarted clock does not deadlock
 	_ = t
@@ -1,2 +1,5 @@
 // Package externalclock provides a clock implementation for external time sources.
+//
+// To use the clock within a testing/synctest bubble, create it within the bubble
+// and advance it with externalclocktest.AdvanceInBubble.
 package externalclock
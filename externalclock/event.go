@@ -0,0 +1,33 @@
+package externalclock
+
+import "time"
+
+// Event describes a time value delivered on the channel of a Ticker or Timer of a Clock.
+type Event struct {
+	// Due is the time at which the ticker or timer was scheduled to fire.
+	Due time.Time
+	// Observed is the time of the clock when the ticker or timer fired,
+	// that is the timestamp passed to SetTimestamp.
+	Observed time.Time
+}
+
+// Latency returns the time elapsed on the clock between when the event was due and when it was observed.
+func (e Event) Latency() time.Duration {
+	return e.Observed.Sub(e.Due)
+}
+
+// EventSource is implemented by the tickers and timers created by a Clock.
+type EventSource interface {
+	// Event returns the Event of a time value received from the channel C.
+	// Values must be looked up in the order they were received. It returns false if the value
+	// is not among the delivered values that have not yet been looked up.
+	Event(received time.Time) (Event, bool)
+}
+
+// value returns the time value to deliver for the event.
+func (e Event) value(sendDeadline bool) time.Time {
+	if sendDeadline {
+		return e.Due
+	}
+	return e.Observed
+}
@@ -0,0 +1,93 @@
+package externalclock_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestEvent_ObservedTimestamps(t *testing.T) {
+	externalClock := newTestFixture(t)
+	loopTicker := externalClock.NewTicker(time.Second)
+	defer loopTicker.Stop()
+	externalClock.SetTimestamp(time.UnixMilli(1300))
+	ts := receiveTick(t, loopTicker.C())
+	assert.Equal(t, time.UnixMilli(1300), ts)
+	event, ok := loopTicker.(externalclock.EventSource).Event(ts)
+	assert.Assert(t, ok)
+	assert.Equal(t, externalclock.Event{Due: time.UnixMilli(1000), Observed: time.UnixMilli(1300)}, event)
+	assert.Equal(t, 300*time.Millisecond, event.Latency())
+}
+
+func TestEvent_DeadlineTimestamps(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0), externalclock.WithDeadlineTimestamps())
+	// Given a timer and a ticker
+	timer := externalClock.NewTimer(1500 * time.Millisecond)
+	loopTicker := externalClock.NewTicker(time.Second)
+	defer loopTicker.Stop()
+	// when the clock passes their deadlines
+	externalClock.SetTimestamp(time.UnixMilli(1700))
+	// then the deadlines should be received
+	ts := receiveTick(t, timer.C())
+	assert.Equal(t, time.UnixMilli(1500), ts)
+	event, ok := timer.(externalclock.EventSource).Event(ts)
+	assert.Assert(t, ok)
+	assert.Equal(t, externalclock.Event{Due: time.UnixMilli(1500), Observed: time.UnixMilli(1700)}, event)
+	ts = receiveTick(t, loopTicker.C())
+	assert.Equal(t, time.UnixMilli(1000), ts)
+	event, ok = loopTicker.(externalclock.EventSource).Event(ts)
+	assert.Assert(t, ok)
+	assert.Equal(t, 700*time.Millisecond, event.Latency())
+	// and unknown values should not have an event
+	_, ok = loopTicker.(externalclock.EventSource).Event(time.UnixMilli(1700))
+	assert.Assert(t, !ok)
+}
+
+func TestEvent_DeadlineTimestamps_CatchUpAll(t *testing.T) {
+	externalClock := externalclock.New(
+		time.Unix(0, 0),
+		externalclock.WithDeadlineTimestamps(),
+		externalclock.WithCatchUpPolicy(externalclock.CatchUpAll),
+	)
+	loopTicker := externalClock.NewTicker(time.Second)
+	defer loopTicker.Stop()
+	received := make(chan time.Time, 10)
+	go func() {
+		for i := 0; i < 3; i++ {
+			received <- <-loopTicker.C()
+		}
+	}()
+	externalClock.SetTimestamp(time.UnixMilli(3500))
+	for i := 1; i <= 3; i++ {
+		assert.Equal(t, time.Unix(int64(i), 0), receiveTick(t, received))
+	}
+}
+
+func TestEvent_ObservedTimestamps_CatchUpAll(t *testing.T) {
+	externalClock := externalclock.New(
+		time.Unix(0, 0),
+		externalclock.WithCatchUpPolicy(externalclock.CatchUpAll),
+		externalclock.WithDeliveryPolicy(externalclock.DeliverBlock),
+	)
+	loopTicker := externalClock.NewTicker(time.Second)
+	defer loopTicker.Stop()
+	events := make(chan externalclock.Event, 10)
+	go func() {
+		for i := 0; i < 3; i++ {
+			ts := <-loopTicker.C()
+			event, ok := loopTicker.(externalclock.EventSource).Event(ts)
+			assert.Check(t, ok)
+			events <- event
+		}
+	}()
+	// When the clock jumps past several ticks, all received with the same observed timestamp
+	externalClock.SetTimestamp(time.UnixMilli(3500))
+	// then each received value should have the event of its own tick
+	for i := 1; i <= 3; i++ {
+		event := <-events
+		assert.Equal(t, time.Unix(int64(i), 0), event.Due)
+		assert.Equal(t, time.UnixMilli(3500), event.Observed)
+	}
+}
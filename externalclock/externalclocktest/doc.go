@@ -0,0 +1,2 @@
+// Package externalclocktest provides helpers for testing code using an externalclock.Clock.
+package externalclocktest
@@ -0,0 +1,23 @@
+//go:build go1.25
+
+package externalclocktest
+
+import (
+	"testing/synctest"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+)
+
+// AdvanceInBubble is like c.AdvanceTo(t), for use within a testing/synctest bubble.
+//
+// Before each step, AdvanceInBubble waits for every other goroutine in the bubble to be durably
+// blocked, so that the goroutines woken by the previous step, and not only AfterFunc callbacks,
+// have reacted to it before the clock advances further.
+//
+// For goroutines blocked on the clock to be durably blocked, the clock must be created within the
+// bubble, and its tickers and timers must be created and received from within the bubble.
+// AdvanceInBubble must be called from within the bubble.
+func AdvanceInBubble(c *externalclock.Clock, t time.Time) {
+	c.AdvanceToWaiting(t, synctest.Wait)
+}
@@ -0,0 +1,99 @@
+//go:build go1.25
+
+package externalclocktest_test
+
+import (
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/externalclock"
+	"go.einride.tech/clock/externalclock/externalclocktest"
+	"go.einride.tech/clock/systemclock"
+	"gotest.tools/v3/assert"
+)
+
+type scenarioEvent struct {
+	Name    string
+	Elapsed time.Duration
+}
+
+// runScenario starts goroutines using the clock and returns a func returning the events they logged.
+func runScenario(c clock.Clock) func() []scenarioEvent {
+	var mutex sync.Mutex
+	var events []scenarioEvent
+	start := c.Now()
+	log := func(name string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		events = append(events, scenarioEvent{Name: name, Elapsed: c.Since(start)})
+	}
+	go func() {
+		for i := 0; i < 3; i++ {
+			c.Sleep(time.Second)
+			log("sleep")
+		}
+	}()
+	go func() {
+		ticker := c.NewTicker(700 * time.Millisecond)
+		defer ticker.Stop()
+		for i := 0; i < 4; i++ {
+			<-ticker.C()
+			log("tick")
+		}
+	}()
+	c.AfterFunc(1500*time.Millisecond, func() {
+		log("after func")
+	})
+	return func() []scenarioEvent {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return events
+	}
+}
+
+func TestExternalClock_Synctest(t *testing.T) {
+	expected := []scenarioEvent{
+		{Name: "tick", Elapsed: 700 * time.Millisecond},
+		{Name: "sleep", Elapsed: time.Second},
+		{Name: "tick", Elapsed: 1400 * time.Millisecond},
+		{Name: "after func", Elapsed: 1500 * time.Millisecond},
+		{Name: "sleep", Elapsed: 2 * time.Second},
+		{Name: "tick", Elapsed: 2100 * time.Millisecond},
+		{Name: "tick", Elapsed: 2800 * time.Millisecond},
+		{Name: "sleep", Elapsed: 3 * time.Second},
+	}
+	t.Run("systemclock", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			events := runScenario(systemclock.New())
+			time.Sleep(4 * time.Second)
+			synctest.Wait()
+			assert.DeepEqual(t, expected, events())
+		})
+	})
+	t.Run("externalclock", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			start := time.Now()
+			externalClock := externalclock.New(start)
+			events := runScenario(externalClock)
+			externalclocktest.AdvanceInBubble(externalClock, start.Add(4*time.Second))
+			assert.DeepEqual(t, expected, events())
+		})
+	})
+}
+
+func TestExternalClock_Synctest_BlockUntil(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		externalClock := externalclock.New(time.Now())
+		done := make(chan struct{})
+		go func() {
+			externalClock.Sleep(time.Second)
+			close(done)
+		}()
+		assert.NilError(t, externalClock.BlockUntil(t.Context(), 1))
+		externalclocktest.AdvanceInBubble(externalClock, externalClock.Now().Add(time.Second))
+		<-done
+	})
+}
@@ -0,0 +1,102 @@
+package externalclock
+
+import "time"
+
+// Option configures a Clock created by New.
+type Option func(*options)
+
+type options struct {
+	catchUpPolicy   CatchUpPolicy
+	sendDeadline    bool
+	deliveryPolicy  DeliveryPolicy
+	deliveryTimeout time.Duration
+	onDrop          func(caller string, value time.Time)
+	backwardsPolicy BackwardsPolicy
+	autoAdvance     bool
+}
+
+func defaultOptions() options {
+	return options{
+		catchUpPolicy:   CatchUpCoalesce,
+		deliveryPolicy:  DeliverBlockWithTimeout,
+		deliveryTimeout: 20 * time.Millisecond,
+	}
+}
+
+// CatchUpPolicy determines how periodic tickers behave when the clock is advanced
+// past more than one of their periods in a single call to SetTimestamp.
+type CatchUpPolicy int
+
+const (
+	// CatchUpCoalesce delivers a single tick for all periods reached, like time.Ticker
+	// does for a slow reader. This is the default policy.
+	CatchUpCoalesce CatchUpPolicy = iota
+	// CatchUpAll delivers one tick for each period reached, in order.
+	// Each tick is subject to the DeliveryPolicy of the clock, so unless the policy blocks
+	// the receiver needs to keep up with reading them for none to be dropped.
+	CatchUpAll
+	// CatchUpDrop delivers no tick when more than one period is reached at once.
+	// The ticker resumes ticking at its next period.
+	CatchUpDrop
+)
+
+// WithCatchUpPolicy sets the CatchUpPolicy of the periodic tickers of the clock.
+// Regardless of policy, tickers stay aligned to the periods counted from when they were
+// created or last reset.
+func WithCatchUpPolicy(policy CatchUpPolicy) Option {
+	return func(o *options) {
+		o.catchUpPolicy = policy
+	}
+}
+
+// WithDeadlineTimestamps makes tickers and timers of the clock send the time at which they were
+// scheduled to fire on their channels, instead of the timestamp passed to SetTimestamp that made them fire.
+// Use EventSource to get both times for a received value.
+func WithDeadlineTimestamps() Option {
+	return func(o *options) {
+		o.sendDeadline = true
+	}
+}
+
+// DeliveryPolicy determines what happens when a ticker or timer of the clock fires
+// while the value previously sent on its channel has not yet been received.
+// Regardless of policy, a delivery in progress is aborted by Stop and Reset.
+type DeliveryPolicy int
+
+const (
+	// DeliverBlockWithTimeout blocks until the receiver catches up or the delivery timeout
+	// set with WithDeliveryTimeout elapses, and then drops the new value. This is the default policy.
+	DeliverBlockWithTimeout DeliveryPolicy = iota
+	// DeliverDropNewest drops the new value without blocking.
+	DeliverDropNewest
+	// DeliverDropOldest replaces the value that has not yet been received with the new value, without blocking.
+	DeliverDropOldest
+	// DeliverBlock blocks until the receiver catches up. Note that this blocks SetTimestamp,
+	// and thereby the delivery to all other tickers and timers of the clock.
+	DeliverBlock
+)
+
+// WithDeliveryPolicy sets the DeliveryPolicy of the tickers and timers of the clock.
+func WithDeliveryPolicy(policy DeliveryPolicy) Option {
+	return func(o *options) {
+		o.deliveryPolicy = policy
+	}
+}
+
+// WithDeliveryTimeout sets how long a delivery blocks before dropping the value,
+// for clocks using DeliverBlockWithTimeout. The default is 20 milliseconds.
+func WithDeliveryTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.deliveryTimeout = timeout
+	}
+}
+
+// WithOnDrop sets a func that is called whenever a ticker or timer of the clock drops a value
+// according to the DeliveryPolicy of the clock. It receives the location where the ticker or timer
+// was created and the dropped time value.
+// The func is called synchronously while the clock is advanced, so it should return quickly.
+func WithOnDrop(f func(caller string, value time.Time)) Option {
+	return func(o *options) {
+		o.onDrop = f
+	}
+}
@@ -0,0 +1,121 @@
+package externalclock
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+	"time"
+)
+
+// tickerHeap is a priority queue of pending tickers, ordered by deadline and then by creation.
+// It is guarded by the clock's ticker mutex.
+type tickerHeap []*ticker
+
+var _ heap.Interface = &tickerHeap{}
+
+func (h tickerHeap) Len() int {
+	return len(h)
+}
+
+func (h tickerHeap) Less(i, j int) bool {
+	return compareTickers(h[i], h[j]) < 0
+}
+
+func (h tickerHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *tickerHeap) Push(x any) {
+	t := x.(*ticker)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+
+func (h *tickerHeap) Pop() any {
+	old := *h
+	n := len(old)
+	t := old[n-1]
+	old[n-1] = nil
+	t.index = -1
+	*h = old[:n-1]
+	return t
+}
+
+// due returns the tickers due at t, in firing order.
+// Since every ticker is due no later than its children in the heap, only the
+// due tickers and their immediate children are visited.
+func (h tickerHeap) due(t time.Time) []*ticker {
+	var result []*ticker
+	var visit func(i int)
+	visit = func(i int) {
+		if i >= len(h) || t.Before(h[i].deadline) {
+			return
+		}
+		result = append(result, h[i])
+		visit(2*i + 1)
+		visit(2*i + 2)
+	}
+	visit(0)
+	slices.SortFunc(result, compareTickers)
+	return result
+}
+
+func compareTickers(a, b *ticker) int {
+	if c := a.deadline.Compare(b.deadline); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.id, b.id)
+}
+
+// registerTicker adds the ticker to the set of pending tickers, due at the deadline.
+// It reports whether the ticker was newly registered, as opposed to rescheduled.
+// The caller must hold the ticker mutex, unless the ticker has not yet been shared.
+func (g *Clock) registerTicker(t *ticker, deadline time.Time) bool {
+	g.tickerMutex.Lock()
+	defer g.tickerMutex.Unlock()
+	t.deadline = deadline
+	if t.index >= 0 {
+		heap.Fix(&g.tickers, t.index)
+		return false
+	}
+	heap.Push(&g.tickers, t)
+	g.notifyTickersChanged()
+	return true
+}
+
+// rescheduleTicker sets the deadline of a pending ticker.
+// It reports whether the ticker was pending.
+// The caller must hold the ticker mutex.
+func (g *Clock) rescheduleTicker(t *ticker, deadline time.Time) bool {
+	g.tickerMutex.Lock()
+	defer g.tickerMutex.Unlock()
+	if t.index < 0 {
+		return false
+	}
+	t.deadline = deadline
+	heap.Fix(&g.tickers, t.index)
+	return true
+}
+
+// unregisterTicker removes the ticker from the set of pending tickers.
+// It reports whether the ticker was pending.
+// The caller must hold the ticker mutex.
+func (g *Clock) unregisterTicker(t *ticker) bool {
+	g.tickerMutex.Lock()
+	defer g.tickerMutex.Unlock()
+	if t.index < 0 {
+		return false
+	}
+	heap.Remove(&g.tickers, t.index)
+	g.notifyTickersChanged()
+	return true
+}
+
+// notifyTickersChanged wakes up goroutines waiting for the number of pending tickers to change.
+// The caller must hold the clock's ticker mutex for writing.
+func (g *Clock) notifyTickersChanged() {
+	close(g.tickersChanged)
+	g.tickersChanged = make(chan struct{})
+}
@@ -0,0 +1,44 @@
+package externalclock_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+)
+
+func BenchmarkClock_SetTimestamp(b *testing.B) {
+	for _, n := range []int{10, 1_000, 10_000} {
+		b.Run(fmt.Sprintf("pending=%d", n), func(b *testing.B) {
+			c := externalclock.New(time.Unix(0, 0))
+			// n timers that are not due during the benchmark
+			for i := 0; i < n; i++ {
+				c.NewTimer(time.Duration(i+1) * time.Hour)
+			}
+			// and one ticker that is due on every iteration
+			loopTicker := c.NewTicker(time.Millisecond)
+			defer loopTicker.Stop()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				c.SetTimestamp(time.Unix(0, int64(i+1)*time.Millisecond.Nanoseconds()))
+				<-loopTicker.C()
+			}
+		})
+	}
+}
+
+func BenchmarkClock_NewTimer(b *testing.B) {
+	for _, n := range []int{10, 1_000, 10_000} {
+		b.Run(fmt.Sprintf("pending=%d", n), func(b *testing.B) {
+			c := externalclock.New(time.Unix(0, 0))
+			for i := 0; i < n; i++ {
+				c.NewTimer(time.Duration(i+1) * time.Hour)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				c.NewTimer(time.Minute).Stop()
+			}
+		})
+	}
+}
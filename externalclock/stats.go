@@ -0,0 +1,31 @@
+package externalclock
+
+// Stats counts the values delivered and dropped by tickers and timers.
+type Stats struct {
+	// Delivered is the number of time values sent on channels, plus the number of AfterFunc calls.
+	Delivered uint64
+	// Dropped is the number of time values dropped according to the DeliveryPolicy of the clock.
+	Dropped uint64
+}
+
+// StatsSource is implemented by the tickers and timers created by a Clock.
+type StatsSource interface {
+	// Stats returns the number of values delivered and dropped by the ticker or timer.
+	Stats() Stats
+}
+
+var (
+	_ StatsSource = &ticker{}
+	_ StatsSource = &Timer{}
+)
+
+func (t *ticker) Stats() Stats {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.stats
+}
+
+// Stats returns the number of values delivered and dropped by the timer.
+func (t *Timer) Stats() Stats {
+	return t.ticker.Stats()
+}
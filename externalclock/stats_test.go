@@ -0,0 +1,69 @@
+package externalclock_test
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestExternalClock_Stats(t *testing.T) {
+	var mutex sync.Mutex
+	var drops []time.Time
+	var callers []string
+	externalClock := externalclock.New(
+		time.Unix(0, 0),
+		externalclock.WithDeliveryPolicy(externalclock.DeliverDropNewest),
+		externalclock.WithOnDrop(func(caller string, value time.Time) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			callers = append(callers, caller)
+			drops = append(drops, value)
+		}),
+	)
+	// Given a ticker that is never received from
+	_, file, line, _ := runtime.Caller(0)
+	slowTicker := externalClock.NewTicker(time.Millisecond)
+	defer slowTicker.Stop()
+	// and a timer that is
+	timer := externalClock.NewTimer(time.Millisecond)
+	// when the clock passes three periods
+	for i := 1; i <= 3; i++ {
+		externalClock.SetTimestamp(time.UnixMilli(int64(i)))
+	}
+	receiveTick(t, timer.C())
+	// then the ticker should have dropped two ticks
+	assert.Equal(t, externalclock.Stats{Delivered: 1, Dropped: 2}, slowTicker.(externalclock.StatsSource).Stats())
+	assert.Equal(t, externalclock.Stats{Delivered: 1}, timer.(externalclock.StatsSource).Stats())
+	assert.Equal(t, externalclock.Stats{Delivered: 2, Dropped: 2}, externalClock.Stats())
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.DeepEqual(t, []time.Time{time.UnixMilli(2), time.UnixMilli(3)}, drops)
+	// and report the location where the ticker was created
+	caller := fmt.Sprintf("%s:%d", file, line+1)
+	assert.DeepEqual(t, []string{caller, caller}, callers)
+}
+
+func TestExternalClock_Stats_DropOldest(t *testing.T) {
+	var drops []time.Time
+	externalClock := externalclock.New(
+		time.Unix(0, 0),
+		externalclock.WithDeliveryPolicy(externalclock.DeliverDropOldest),
+		externalclock.WithOnDrop(func(_ string, value time.Time) {
+			drops = append(drops, value)
+		}),
+	)
+	slowTicker := externalClock.NewTicker(time.Millisecond)
+	defer slowTicker.Stop()
+	externalClock.SetTimestamp(time.UnixMilli(1))
+	externalClock.SetTimestamp(time.UnixMilli(2))
+	assert.Equal(t, time.UnixMilli(2), receiveTick(t, slowTicker.C()))
+	// the replaced value should only count as dropped
+	assert.Equal(t, externalclock.Stats{Delivered: 1, Dropped: 1}, slowTicker.(externalclock.StatsSource).Stats())
+	assert.Equal(t, externalclock.Stats{Delivered: 1, Dropped: 1}, externalClock.Stats())
+	assert.DeepEqual(t, []time.Time{time.UnixMilli(1)}, drops)
+}
@@ -0,0 +1,40 @@
+package externalclock
+
+import "sync"
+
+// subscribers is a set of funcs subscribed to events of type T.
+type subscribers[T any] struct {
+	mutex       sync.Mutex
+	nextID      uint64
+	subscribers map[uint64]func(T)
+}
+
+// subscribe adds f to the subscribers and returns a func removing it.
+func (s *subscribers[T]) subscribe(f func(T)) (cancel func()) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.subscribers == nil {
+		s.subscribers = map[uint64]func(T){}
+	}
+	id := s.nextID
+	s.nextID++
+	s.subscribers[id] = f
+	return func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		delete(s.subscribers, id)
+	}
+}
+
+// notify calls the subscribers with the event, without holding the mutex.
+func (s *subscribers[T]) notify(event T) {
+	s.mutex.Lock()
+	fs := make([]func(T), 0, len(s.subscribers))
+	for _, f := range s.subscribers {
+		fs = append(fs, f)
+	}
+	s.mutex.Unlock()
+	for _, f := range fs {
+		f(event)
+	}
+}
@@ -1,9 +1,7 @@
 package externalclock
 
 import (
-	"fmt"
 	"log/slog"
-	"runtime"
 	"sync"
 	"time"
 
@@ -11,14 +9,32 @@ import (
 )
 
 type ticker struct {
-	mutex         sync.Mutex
-	caller        string
-	lastTimeStamp time.Time
-	duration      time.Duration
-	timeChan      chan time.Time
-	stopFunc      func()
-	isPeriodic    bool
-	getTimeFunc   func() time.Time
+	mutex sync.Mutex
+	// id identifies the ticker within its clock and orders tickers by creation.
+	id     uint64
+	clock  *Clock
+	caller string
+	// deadline is the time at which the ticker is next due.
+	// It is only written while holding both the ticker mutex and the clock's ticker mutex,
+	// so holding either one is enough to read it.
+	deadline time.Time
+	// index is the position of the ticker in the clock's heap of pending tickers,
+	// or -1 when the ticker is not pending. It is guarded by the clock's ticker mutex.
+	index    int
+	duration time.Duration
+	// generation is incremented on every Stop and Reset, so that an ongoing delivery of
+	// missed ticks can detect that it has been interrupted.
+	generation uint64
+	// inFlight is the delivery in progress while the ticker mutex is released
+	// by a blocking send, or nil.
+	inFlight *delivery
+	timeChan chan time.Time
+	// events holds the events sent on the channel that have not yet been looked up by Event,
+	// in send order and at most maxEvents of them.
+	events     []Event
+	stats      Stats
+	afterFunc  func()
+	isPeriodic bool
 }
 
 func (t *ticker) C() <-chan time.Time {
@@ -26,71 +42,322 @@ func (t *ticker) C() <-chan time.Time {
 }
 
 func (t *ticker) Stop() {
-	t.stopFunc()
+	t.stop()
 }
 
 func (t *ticker) Reset(duration time.Duration) {
-	now := t.getTimeFunc()
-	t.mutex.Lock()
-	t.duration = duration
-	t.lastTimeStamp = now
-	t.mutex.Unlock()
+	t.reset(duration)
 }
 
-func (t *ticker) IsDurationReached(currentTime time.Time) bool {
+func (t *ticker) Event(received time.Time) (Event, bool) {
+	sendDeadline := t.clock.options.sendDeadline
 	t.mutex.Lock()
-	dur := t.duration
-	ts := t.lastTimeStamp
-	t.mutex.Unlock()
-	return dur <= currentTime.Sub(ts)
+	defer t.mutex.Unlock()
+	// Values are received in send order, so the oldest event with the value is the one received.
+	for i, e := range t.events {
+		if e.value(sendDeadline).Equal(received) {
+			t.events = t.events[i+1:]
+			return e, true
+		}
+	}
+	return Event{}, false
 }
 
-func (t *ticker) GetLastTimestamp() time.Time {
+// maxEvents is the number of events kept for lookup by Event.
+const maxEvents = 16
+
+// stop unregisters the ticker and discards any pending value on its channel.
+// It reports whether the call prevented a value from being received, that is whether the ticker
+// was registered or had a value pending on its channel that had not yet been received.
+func (t *ticker) stop() bool {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
-	return t.lastTimeStamp
+	t.generation++
+	interrupted := t.interruptDelivery()
+	registered := t.clock.unregisterTicker(t)
+	drained := t.drain()
+	return registered || drained || interrupted
 }
 
-func (t *ticker) SetLastTimestamp(lastTimestamp time.Time) {
+// reset restarts the ticker with the new duration, counted from the current time,
+// and discards any pending value on its channel.
+// It reports whether the ticker was active, with the same meaning as for stop.
+func (t *ticker) reset(duration time.Duration) bool {
+	// Lock the time before the ticker, like rebaseTickers, so that a rebase cannot happen
+	// between reading the time and registering the new deadline.
+	t.clock.timeMutex.Lock()
+	defer t.clock.timeMutex.Unlock()
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
-	t.lastTimeStamp = lastTimestamp
+	t.generation++
+	interrupted := t.interruptDelivery()
+	drained := t.drain()
+	t.duration = duration
+	registered := !t.clock.registerTicker(t, t.clock.currentTime.Add(duration))
+	return registered || drained || interrupted
 }
 
-func (g *Clock) NewTicker(d time.Duration) clock.Ticker {
-	_, file, no, ok := runtime.Caller(1)
-	var caller string
-	if ok {
-		caller = fmt.Sprintf("called from %s#%d\n", file, no)
+// drain discards any value buffered on the ticker channel and reports whether there was one.
+// The caller must hold the ticker mutex.
+func (t *ticker) drain() bool {
+	select {
+	case <-t.timeChan:
+		t.forgetEvent(len(t.events) - 1)
+		return true
+	default:
+		return false
+	}
+}
+
+// fire delivers currentTime on the ticker channel if the ticker is due,
+// or calls the after func in its own goroutine for tickers created by AfterFunc.
+// One-shot tickers are unregistered before the value is delivered.
+func (t *ticker) fire(currentTime time.Time) {
+	t.mutex.Lock()
+	t.awaitDelivery()
+	if currentTime.Before(t.deadline) {
+		t.mutex.Unlock()
+		return
+	}
+	if !t.isPeriodic {
+		defer t.mutex.Unlock()
+		if !t.clock.unregisterTicker(t) {
+			// Stopped after the ticker was found due.
+			return
+		}
+		if t.afterFunc != nil {
+			t.delivered()
+			t.clock.callbacks.run(t.afterFunc)
+			return
+		}
+		t.send(Event{Due: t.deadline, Observed: currentTime})
+		return
+	}
+	firstDue := t.deadline
+	missed := 1
+	nextDeadline := currentTime
+	if t.duration > 0 {
+		missed += int(currentTime.Sub(t.deadline) / t.duration)
+		nextDeadline = t.deadline.Add(time.Duration(missed) * t.duration)
 	}
+	if !t.clock.rescheduleTicker(t, nextDeadline) {
+		// Stopped after the ticker was found due.
+		t.mutex.Unlock()
+		return
+	}
+	// Deliver the ticks due at firstDue + i * duration for i in [first, missed).
+	first := missed - 1
+	switch t.clock.options.catchUpPolicy {
+	case CatchUpAll:
+		first = 0
+	case CatchUpDrop:
+		if missed > 1 {
+			first = missed
+		}
+	}
+	generation := t.generation
+	for i := first; i < missed; i++ {
+		if i > first {
+			// Let Stop and Reset interrupt the delivery of missed ticks.
+			t.mutex.Unlock()
+			t.mutex.Lock()
+		}
+		if t.generation != generation {
+			break
+		}
+		t.send(Event{Due: firstDue.Add(time.Duration(i) * t.duration), Observed: currentTime})
+	}
+	t.mutex.Unlock()
+}
+
+// delivery is a blocking send on the ticker channel.
+type delivery struct {
+	// cancel is closed to abort the send.
+	cancel    chan struct{}
+	cancelled bool
+	// done is closed once the send has completed or been aborted.
+	done      chan struct{}
+	delivered bool
+}
+
+// send delivers the event on the ticker channel according to the delivery policy of the clock.
+// The caller must hold the ticker mutex, which is released while a blocking send is in progress.
+func (t *ticker) send(e Event) {
+	value := e.value(t.clock.options.sendDeadline)
+	// Record the event before sending, since the value may be received while a blocking send
+	// has released the ticker mutex.
+	if len(t.events) == maxEvents {
+		t.events = t.events[1:]
+	}
+	t.events = append(t.events, e)
+	var delivered, interrupted bool
+	switch t.clock.options.deliveryPolicy {
+	case DeliverDropNewest:
+		select {
+		case t.timeChan <- value:
+			delivered = true
+		default:
+		}
+	case DeliverDropOldest:
+		for !delivered {
+			select {
+			case t.timeChan <- value:
+				delivered = true
+			case oldest := <-t.timeChan:
+				t.forgetEvent(len(t.events) - 2)
+				t.undelivered()
+				t.dropped(oldest)
+			}
+		}
+	default:
+		delivered, interrupted = t.sendBlocking(value)
+	}
+	if !delivered {
+		t.forgetEvent(len(t.events) - 1)
+	}
+	switch {
+	case delivered:
+		t.delivered()
+		if t.clock.options.autoAdvance {
+			t.clock.autoAdvancer.notify(t.timeChan)
+		}
+	case !interrupted:
+		t.dropped(value)
+	}
+}
+
+// forgetEvent removes the event at index i, if any, after its value was discarded from the channel.
+// The caller must hold the ticker mutex.
+func (t *ticker) forgetEvent(i int) {
+	if i >= 0 && i < len(t.events) {
+		t.events = append(t.events[:i], t.events[i+1:]...)
+	}
+}
+
+// delivered records the delivery of a value.
+// The caller must hold the ticker mutex.
+func (t *ticker) delivered() {
+	t.stats.Delivered++
+	t.clock.delivered.Add(1)
+}
+
+// undelivered reverts the delivery of a value that was discarded from the channel before being received.
+// The caller must hold the ticker mutex.
+func (t *ticker) undelivered() {
+	t.stats.Delivered--
+	t.clock.delivered.Add(^uint64(0))
+}
+
+// dropped records that the value was dropped and calls the OnDrop func of the clock, if any.
+// The caller must hold the ticker mutex, which is released while calling the OnDrop func.
+func (t *ticker) dropped(value time.Time) {
+	slog.Debug("ticker dropped message", slog.String("caller", t.caller))
+	t.stats.Dropped++
+	t.clock.dropped.Add(1)
+	if onDrop := t.clock.options.onDrop; onDrop != nil {
+		t.mutex.Unlock()
+		onDrop(t.caller, value)
+		t.mutex.Lock()
+	}
+}
+
+// sendBlocking sends the value on the ticker channel, blocking until it is received, until the
+// delivery timeout elapses if the policy is DeliverBlockWithTimeout, or until interrupted by Stop or Reset.
+// It reports whether the value was delivered, and if not whether the delivery was interrupted.
+// The caller must hold the ticker mutex, which is released while blocking.
+func (t *ticker) sendBlocking(value time.Time) (delivered, interrupted bool) {
+	select {
+	case t.timeChan <- value:
+		return true, false
+	default:
+	}
+	var timeout <-chan time.Time
+	if t.clock.options.deliveryPolicy == DeliverBlockWithTimeout {
+		timer := time.NewTimer(t.clock.options.deliveryTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	d := &delivery{cancel: make(chan struct{}), done: make(chan struct{})}
+	t.inFlight = d
+	t.mutex.Unlock()
+	select {
+	case t.timeChan <- value:
+		d.delivered = true
+	case <-d.cancel:
+	case <-timeout:
+	}
+	t.mutex.Lock()
+	t.inFlight = nil
+	close(d.done)
+	return d.delivered, d.cancelled
+}
+
+// awaitDelivery waits for any delivery in progress to complete.
+// The caller must hold the ticker mutex, which is released while waiting.
+func (t *ticker) awaitDelivery() {
+	for t.inFlight != nil {
+		d := t.inFlight
+		t.mutex.Unlock()
+		<-d.done
+		t.mutex.Lock()
+	}
+}
+
+// interruptDelivery aborts any delivery in progress and waits for it to complete.
+// It reports whether a value was prevented from being delivered.
+// The caller must hold the ticker mutex, which is released while waiting.
+func (t *ticker) interruptDelivery() bool {
+	interrupted := false
+	for t.inFlight != nil {
+		d := t.inFlight
+		if !d.cancelled {
+			close(d.cancel)
+			d.cancelled = true
+		}
+		t.mutex.Unlock()
+		<-d.done
+		t.mutex.Lock()
+		if !d.delivered {
+			interrupted = true
+		}
+	}
+	return interrupted
+}
+
+var _ EventSource = &ticker{}
+
+// NewTicker returns a new Ticker that ticks every time the clock passes a multiple of d from now.
+// The returned Ticker implements EventSource and StatsSource.
+func (g *Clock) NewTicker(d time.Duration) clock.Ticker {
+	caller := callerLocation(2)
 	slog.Debug("added new ticker", slog.String("caller", caller))
 	return g.newTickerInternal(caller, nil, d, true)
 }
 
-func (g *Clock) newTickerInternal(caller string, endFunc func(), d time.Duration, periodic bool) clock.Ticker {
-	// Give the channel a 1-element time buffer.
-	// If the client falls behind while reading, we drop ticks
-	// on the floor until the client catches up.
-	c := make(chan time.Time, 1)
-	uuid := makeUUID()
+func (g *Clock) newTickerInternal(caller string, afterFunc func(), d time.Duration, periodic bool) *ticker {
+	var c chan time.Time
+	if afterFunc == nil {
+		// Give the channel a 1-element time buffer.
+		// If the client falls behind while reading, we drop ticks
+		// on the floor until the client catches up.
+		c = make(chan time.Time, 1)
+	}
 	intervalTicker := &ticker{
-		caller:   caller,
-		timeChan: c,
-		duration: d,
-		stopFunc: func() {
-			g.tickerMutex.Lock()
-			delete(g.tickers, uuid)
-			g.tickerMutex.Unlock()
-			if endFunc != nil {
-				endFunc()
-			}
-		},
-		isPeriodic:  periodic,
-		getTimeFunc: g.getTime,
+		clock:      g,
+		index:      -1,
+		caller:     caller,
+		timeChan:   c,
+		duration:   d,
+		afterFunc:  afterFunc,
+		isPeriodic: periodic,
 	}
-	intervalTicker.SetLastTimestamp(g.getTime())
 	g.tickerMutex.Lock()
-	g.tickers[uuid] = intervalTicker
+	intervalTicker.id = g.nextTickerID
+	g.nextTickerID++
 	g.tickerMutex.Unlock()
+	// Register under the time mutex, like reset, so that the deadline is not missed by a rebase.
+	g.timeMutex.Lock()
+	g.registerTicker(intervalTicker, g.currentTime.Add(d))
+	g.timeMutex.Unlock()
 	return intervalTicker
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"go.einride.tech/clock/externalclock"
 	"gotest.tools/v3/assert"
 )
 
@@ -46,3 +47,169 @@ func TestExternalClock_TickerReset(t *testing.T) {
 	cancel <- struct{}{}
 	assert.DeepEqual(t, receivedTime, []time.Time{time.UnixMilli(3), time.UnixMilli(8)})
 }
+
+func TestExternalClock_TickerPhaseAligned(t *testing.T) {
+	externalClock := newTestFixture(t)
+	// Given a ticker ticking every second
+	loopTicker := externalClock.NewTicker(time.Second)
+	defer loopTicker.Stop()
+	// when the time passes the first period late
+	externalClock.SetTimestamp(time.UnixMilli(1500))
+	assert.Equal(t, time.UnixMilli(1500), receiveTick(t, loopTicker.C()))
+	// then the next tick should still be at the second period
+	externalClock.SetTimestamp(time.UnixMilli(2000))
+	assert.Equal(t, time.UnixMilli(2000), receiveTick(t, loopTicker.C()))
+}
+
+func TestExternalClock_TickerCatchUpPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		policy        externalclock.CatchUpPolicy
+		expectedTicks int
+	}{
+		{name: "coalesce", policy: externalclock.CatchUpCoalesce, expectedTicks: 1},
+		{name: "all", policy: externalclock.CatchUpAll, expectedTicks: 10},
+		{name: "drop", policy: externalclock.CatchUpDrop, expectedTicks: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			externalClock := externalclock.New(
+				time.Unix(0, 0),
+				externalclock.WithCatchUpPolicy(tt.policy),
+				externalclock.WithDeliveryPolicy(externalclock.DeliverBlock),
+			)
+			// Given a ticker ticking every second
+			loopTicker := externalClock.NewTicker(time.Second)
+			defer loopTicker.Stop()
+			// when jumping 10 seconds ahead
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				externalClock.SetTimestamp(time.UnixMilli(10500))
+			}()
+			for i := 0; i < tt.expectedTicks; i++ {
+				receiveTick(t, loopTicker.C())
+			}
+			<-done
+			assertNoTick(t, loopTicker.C())
+			// then the ticker should resume ticking at its next period
+			externalClock.SetTimestamp(time.UnixMilli(10999))
+			assertNoTick(t, loopTicker.C())
+			externalClock.SetTimestamp(time.UnixMilli(11000))
+			assert.Equal(t, time.Unix(11, 0), receiveTick(t, loopTicker.C()))
+			assertNoTick(t, loopTicker.C())
+		})
+	}
+}
+
+func TestExternalClock_DeliveryPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		opts     []externalclock.Option
+		expected []time.Time
+	}{
+		{
+			name:     "block with timeout",
+			opts:     []externalclock.Option{externalclock.WithDeliveryTimeout(time.Millisecond)},
+			expected: []time.Time{time.UnixMilli(1)},
+		},
+		{
+			name:     "drop newest",
+			opts:     []externalclock.Option{externalclock.WithDeliveryPolicy(externalclock.DeliverDropNewest)},
+			expected: []time.Time{time.UnixMilli(1)},
+		},
+		{
+			name:     "drop oldest",
+			opts:     []externalclock.Option{externalclock.WithDeliveryPolicy(externalclock.DeliverDropOldest)},
+			expected: []time.Time{time.UnixMilli(2)},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			externalClock := externalclock.New(time.Unix(0, 0), tt.opts...)
+			// Given a ticker that has ticked without being received from
+			loopTicker := externalClock.NewTicker(time.Millisecond)
+			defer loopTicker.Stop()
+			externalClock.SetTimestamp(time.UnixMilli(1))
+			// when it ticks again
+			externalClock.SetTimestamp(time.UnixMilli(2))
+			// then the expected ticks should be received
+			for _, expected := range tt.expected {
+				assert.Equal(t, expected, receiveTick(t, loopTicker.C()))
+			}
+			select {
+			case ts := <-loopTicker.C():
+				t.Fatalf("unexpected tick %v", ts)
+			default:
+			}
+		})
+	}
+}
+
+func TestExternalClock_DeliveryPolicy_Block(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0), externalclock.WithDeliveryPolicy(externalclock.DeliverBlock))
+	// Given a ticker that has ticked without being received from
+	loopTicker := externalClock.NewTicker(time.Millisecond)
+	defer loopTicker.Stop()
+	externalClock.SetTimestamp(time.UnixMilli(1))
+	// when it ticks again
+	done := make(chan struct{})
+	go func() {
+		externalClock.SetTimestamp(time.UnixMilli(2))
+		close(done)
+	}()
+	// then the clock should block until both ticks are received
+	select {
+	case <-done:
+		t.Fatal("SetTimestamp did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, time.UnixMilli(1), receiveTick(t, loopTicker.C()))
+	assert.Equal(t, time.UnixMilli(2), receiveTick(t, loopTicker.C()))
+	<-done
+}
+
+func TestExternalClock_DeliveryPolicy_Block_Stop(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(0, 0), externalclock.WithDeliveryPolicy(externalclock.DeliverBlock))
+	// Given a timer blocked delivering its tick
+	loopTicker := externalClock.NewTicker(time.Millisecond)
+	externalClock.SetTimestamp(time.UnixMilli(1))
+	done := make(chan struct{})
+	go func() {
+		externalClock.SetTimestamp(time.UnixMilli(2))
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	// when stopping the ticker
+	loopTicker.Stop()
+	// then the clock should be unblocked and no tick received
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("SetTimestamp still blocked after Stop")
+	}
+	select {
+	case ts := <-loopTicker.C():
+		t.Fatalf("unexpected tick %v", ts)
+	default:
+	}
+}
+
+// assertNoTick asserts that no tick is pending on the channel.
+func assertNoTick(t *testing.T, c <-chan time.Time) {
+	t.Helper()
+	select {
+	case ts := <-c:
+		t.Fatalf("unexpected tick %v", ts)
+	default:
+	}
+}
+
+func receiveTick(t *testing.T, c <-chan time.Time) time.Time {
+	t.Helper()
+	select {
+	case ts := <-c:
+		return ts
+	case <-time.After(time.Second):
+		t.Fatal("no tick received")
+		return time.Time{}
+	}
+}
@@ -11,21 +11,48 @@ import (
 // unless the Timer was created by AfterFunc.
 // A Timer must be created with NewTimer or AfterFunc.
 type Timer struct {
-	clock.Ticker
+	ticker *ticker
 }
 
+var (
+	_ clock.Timer = &Timer{}
+	_ EventSource = &Timer{}
+)
+
 // NewTimer creates a new Timer that will send
 // the current time on its channel after at least duration d.
-func (g *Clock) NewTimer(d time.Duration) *Timer {
+func (g *Clock) NewTimer(d time.Duration) clock.Timer {
 	return &Timer{
-		Ticker: g.newTickerInternal("not set", nil, d, false),
+		ticker: g.newTickerInternal(callerLocation(2), nil, d, false),
 	}
 }
 
+// C returns the channel on which the time is delivered when the Timer expires.
+func (t *Timer) C() <-chan time.Time {
+	return t.ticker.C()
+}
+
 // Stop prevents the Timer from firing.
 // It returns true if the call stops the timer, false if the timer has already
 // expired or been stopped.
+//
+// As with time.Timer since Go 1.23, a timer that has expired but whose time has not yet
+// been received from C is considered active: Stop discards the pending time and returns true.
 func (t *Timer) Stop() bool {
-	t.Ticker.Stop()
-	return true
+	return t.ticker.stop()
+}
+
+// Reset changes the timer to expire after duration d, counted from the current time of the clock.
+// It returns true if the timer had been active, false if the timer had expired or been stopped.
+// Like for Stop, a timer whose time has not yet been received from C is considered active.
+//
+// As with time.Timer since Go 1.23, any value pending on the timer channel is discarded,
+// so no stale time is received after Reset returns.
+func (t *Timer) Reset(d time.Duration) bool {
+	return t.ticker.reset(d)
+}
+
+// Event returns the Event of a time value received from C.
+func (t *Timer) Event(received time.Time) (Event, bool) {
+	return t.ticker.Event(received)
 }
@@ -0,0 +1,241 @@
+package externalclock_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/externalclock"
+	"go.einride.tech/clock/systemclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestTimer_Reset_Active(t *testing.T) {
+	externalClock := newTestFixture(t)
+	// Given a timer set to expire at 10ms
+	timer := externalClock.NewTimer(10 * time.Millisecond)
+	externalClock.SetTimestamp(time.UnixMilli(5))
+	// when resetting it to expire 10ms from now
+	assert.Assert(t, timer.Reset(10*time.Millisecond))
+	// then it should not fire at the old deadline
+	externalClock.SetTimestamp(time.UnixMilli(10))
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired at the deadline before Reset")
+	case <-time.After(time.Millisecond):
+	}
+	// but at the new one
+	externalClock.SetTimestamp(time.UnixMilli(15))
+	select {
+	case ts := <-timer.C():
+		assert.Equal(t, time.UnixMilli(15), ts)
+	case <-time.After(time.Second):
+		t.Fatal("timer did not fire at the deadline after Reset")
+	}
+}
+
+func TestTimer_Reset_Expired(t *testing.T) {
+	externalClock := newTestFixture(t)
+	// Given a timer that has expired without being received from
+	timer := externalClock.NewTimer(time.Millisecond)
+	externalClock.SetTimestamp(time.UnixMilli(1))
+	assert.Equal(t, externalClock.NumberOfTriggers(), 0)
+	// when resetting it, it is still considered active since the time was never received
+	assert.Assert(t, timer.Reset(time.Millisecond))
+	assert.Equal(t, externalClock.NumberOfTriggers(), 1)
+	// then the stale value should not be received
+	select {
+	case <-timer.C():
+		t.Fatal("received stale value after Reset")
+	default:
+	}
+	// and the timer should fire again
+	externalClock.SetTimestamp(time.UnixMilli(2))
+	select {
+	case ts := <-timer.C():
+		assert.Equal(t, time.UnixMilli(2), ts)
+	case <-time.After(time.Second):
+		t.Fatal("timer did not fire after Reset")
+	}
+}
+
+func TestTimer_Reset_Stopped(t *testing.T) {
+	externalClock := newTestFixture(t)
+	timer := externalClock.NewTimer(time.Millisecond)
+	timer.Stop()
+	assert.Assert(t, !timer.Reset(time.Millisecond))
+	externalClock.SetTimestamp(time.UnixMilli(1))
+	select {
+	case <-timer.C():
+	case <-time.After(time.Second):
+		t.Fatal("timer did not fire after Reset")
+	}
+}
+
+func TestTimer_Stop(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		prepare  func(*testing.T, clock.Timer, *externalclock.Clock)
+		expected bool
+	}{
+		{
+			name:     "pending",
+			prepare:  func(*testing.T, clock.Timer, *externalclock.Clock) {},
+			expected: true,
+		},
+		{
+			name: "expired and not received",
+			prepare: func(_ *testing.T, _ clock.Timer, c *externalclock.Clock) {
+				c.SetTimestamp(time.UnixMilli(1))
+			},
+			expected: true,
+		},
+		{
+			name: "expired and received",
+			prepare: func(t *testing.T, timer clock.Timer, c *externalclock.Clock) {
+				c.SetTimestamp(time.UnixMilli(1))
+				select {
+				case <-timer.C():
+				case <-time.After(time.Second):
+					t.Fatal("timer did not fire")
+				}
+			},
+			expected: false,
+		},
+		{
+			name: "stopped",
+			prepare: func(_ *testing.T, timer clock.Timer, _ *externalclock.Clock) {
+				timer.Stop()
+			},
+			expected: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			externalClock := newTestFixture(t)
+			timer := externalClock.NewTimer(time.Millisecond)
+			tt.prepare(t, timer, externalClock)
+			assert.Equal(t, tt.expected, timer.Stop())
+			assert.Equal(t, externalClock.NumberOfTriggers(), 0)
+			// no value should be received after Stop
+			externalClock.SetTimestamp(time.UnixMilli(2))
+			select {
+			case <-timer.C():
+				t.Fatal("received value after Stop")
+			case <-time.After(time.Millisecond):
+			}
+		})
+	}
+}
+
+func TestTimer_Stop_DrainIdiom(t *testing.T) {
+	// The idiom below must not block for a timer that has expired without its time being received,
+	// for the system clock as well as for the external clock.
+	externalClock := newTestFixture(t)
+	for _, c := range []struct {
+		name    string
+		clock   clock.Clock
+		advance func()
+	}{
+		{
+			name:    "systemclock",
+			clock:   systemclock.New(),
+			advance: func() { time.Sleep(5 * time.Millisecond) },
+		},
+		{
+			name:    "externalclock",
+			clock:   externalClock,
+			advance: func() { externalClock.SetTimestamp(time.UnixMilli(1)) },
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			timer := c.clock.NewTimer(time.Millisecond)
+			c.advance()
+			done := make(chan struct{})
+			go func() {
+				if !timer.Stop() {
+					<-timer.C()
+				}
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("drain idiom blocked")
+			}
+		})
+	}
+}
+
+func TestTimer_AfterFunc(t *testing.T) {
+	// The behaviour of timers created by AfterFunc should match between the system clock
+	// and the external clock.
+	for _, tt := range []struct {
+		name     string
+		duration time.Duration
+		// stopBeforeExpiry stops the timer before the clock reaches the deadline.
+		stopBeforeExpiry bool
+		expectedCalls    int
+	}{
+		{name: "expires", duration: time.Millisecond, stopBeforeExpiry: false, expectedCalls: 1},
+		// The duration is long so that the system timer cannot expire before it is stopped.
+		{name: "stopped", duration: time.Hour, stopBeforeExpiry: true, expectedCalls: 0},
+	} {
+		externalClock := newTestFixture(t)
+		for _, c := range []struct {
+			name    string
+			clock   clock.Clock
+			advance func()
+		}{
+			{
+				name:    "systemclock",
+				clock:   systemclock.New(),
+				advance: func() { time.Sleep(5 * time.Millisecond) },
+			},
+			{
+				name:    "externalclock",
+				clock:   externalClock,
+				advance: func() { externalClock.SetTimestamp(time.UnixMilli(1)) },
+			},
+		} {
+			t.Run(tt.name+"/"+c.name, func(t *testing.T) {
+				calls := make(chan struct{}, 1)
+				timer := c.clock.AfterFunc(tt.duration, func() {
+					calls <- struct{}{}
+				})
+				assert.Assert(t, timer.C() == nil)
+				if tt.stopBeforeExpiry {
+					assert.Assert(t, timer.Stop())
+				}
+				c.advance()
+				if tt.expectedCalls > 0 {
+					select {
+					case <-calls:
+					case <-time.After(time.Second):
+						t.Fatal("f was not called on expiry")
+					}
+				}
+				// the timer has either run f or been stopped already
+				assert.Assert(t, !timer.Stop())
+				select {
+				case <-calls:
+					t.Fatal("f was called after Stop")
+				case <-time.After(5 * time.Millisecond):
+				}
+			})
+		}
+	}
+}
+
+func TestTimer_AfterFunc_Reset(t *testing.T) {
+	externalClock := newTestFixture(t)
+	calls := make(chan time.Time, 2)
+	timer := externalClock.AfterFunc(time.Millisecond, func() {
+		calls <- externalClock.Now()
+	})
+	externalClock.SetTimestamp(time.UnixMilli(1))
+	assert.Equal(t, time.UnixMilli(1), <-calls)
+	// Resetting an expired AfterFunc timer schedules f to run again.
+	assert.Assert(t, !timer.Reset(time.Millisecond))
+	externalClock.SetTimestamp(time.UnixMilli(2))
+	assert.Equal(t, time.UnixMilli(2), <-calls)
+}
@@ -0,0 +1,188 @@
+package frozenclock
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.einride.tech/clock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TB is the subset of testing.TB used to fail tests.
+type TB interface {
+	Helper()
+	Fatal(args ...any)
+}
+
+// Clock is a clock frozen at an instant.
+type Clock struct {
+	mutex sync.Mutex
+	now   time.Time
+	tb    TB
+}
+
+var _ clock.Clock = &Clock{}
+
+// New returns a new clock frozen at now.
+// Methods waiting on the clock panic. Use NewT to fail a test instead.
+func New(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// NewT returns a new clock frozen at now, which fails the test when waited on.
+func NewT(tb TB, now time.Time) *Clock {
+	return &Clock{now: now, tb: tb}
+}
+
+// Set freezes the clock at t.
+func (c *Clock) Set(t time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = t
+}
+
+// Step moves the clock by d, which may be negative.
+func (c *Clock) Step(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func (c *Clock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *Clock) NowProto() *timestamppb.Timestamp {
+	return timestamppb.New(c.Now())
+}
+
+func (c *Clock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *Clock) Until(t time.Time) time.Duration {
+	return t.Sub(c.Now())
+}
+
+// After returns a channel on which the current time is already sent for a negative or zero duration,
+// and otherwise fails the test or panics, since the clock never advances.
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		return c.newTimer(nil).C()
+	}
+	c.fail("After", d)
+	return nil
+}
+
+// AfterFunc calls f in its own goroutine and returns an expired timer for a negative or zero duration,
+// and otherwise fails the test or panics, since the clock never advances.
+func (c *Clock) AfterFunc(d time.Duration, f func()) clock.Timer {
+	if d <= 0 {
+		return c.newTimer(f)
+	}
+	c.fail("AfterFunc", d)
+	return nil
+}
+
+// NewTicker fails the test or panics, since the clock never advances.
+func (c *Clock) NewTicker(d time.Duration) clock.Ticker {
+	c.fail("NewTicker", d)
+	return nil
+}
+
+// NewTimer returns an expired timer for a negative or zero duration,
+// and otherwise fails the test or panics, since the clock never advances.
+func (c *Clock) NewTimer(d time.Duration) clock.Timer {
+	if d <= 0 {
+		return c.newTimer(nil)
+	}
+	c.fail("NewTimer", d)
+	return nil
+}
+
+// Sleep returns immediately for a negative or zero duration,
+// and otherwise fails the test or panics, since the clock never advances.
+func (c *Clock) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	c.fail("Sleep", d)
+}
+
+// Tick returns nil for a negative or zero duration,
+// and otherwise fails the test or panics, since the clock never advances.
+func (c *Clock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		return nil
+	}
+	c.fail("Tick", d)
+	return nil
+}
+
+// newTimer returns a timer which has already fired, calling f if not nil.
+func (c *Clock) newTimer(f func()) *timer {
+	t := &timer{clock: c, f: f}
+	if f == nil {
+		t.c = make(chan time.Time, 1)
+	}
+	t.fire()
+	return t
+}
+
+func (c *Clock) fail(method string, d time.Duration) {
+	msg := fmt.Sprintf(
+		"frozenclock: %s(%v) would block forever on a frozen clock; use externalclock to test code that waits",
+		method,
+		d,
+	)
+	if c.tb != nil {
+		c.tb.Helper()
+		c.tb.Fatal(msg)
+		return
+	}
+	panic(msg)
+}
+
+// timer is a timer on a frozen clock, which can only fire immediately.
+type timer struct {
+	clock *Clock
+	c     chan time.Time
+	f     func()
+}
+
+func (t *timer) C() <-chan time.Time {
+	return t.c
+}
+
+// Stop returns false, since the timer has always expired.
+func (t *timer) Stop() bool {
+	return false
+}
+
+// Reset fires the timer again for a negative or zero duration,
+// and otherwise fails the test or panics, since the clock never advances.
+func (t *timer) Reset(d time.Duration) bool {
+	if d > 0 {
+		t.clock.fail("Reset", d)
+		return false
+	}
+	if t.c != nil {
+		select {
+		case <-t.c:
+		default:
+		}
+	}
+	t.fire()
+	return false
+}
+
+func (t *timer) fire() {
+	if t.f != nil {
+		go t.f()
+		return
+	}
+	t.c <- t.clock.Now()
+}
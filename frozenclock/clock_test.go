@@ -0,0 +1,86 @@
+package frozenclock_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/frozenclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestFrozenClock_Now(t *testing.T) {
+	frozenClock := frozenclock.New(time.Unix(10, 0))
+	assert.Equal(t, time.Unix(10, 0), frozenClock.Now())
+	assert.Assert(t, frozenClock.NowProto().AsTime().Equal(time.Unix(10, 0)))
+	assert.Equal(t, 10*time.Second, frozenClock.Since(time.Unix(0, 0)))
+	assert.Equal(t, -10*time.Second, frozenClock.Until(time.Unix(0, 0)))
+}
+
+func TestFrozenClock_SetStep(t *testing.T) {
+	frozenClock := frozenclock.New(time.Unix(10, 0))
+	frozenClock.Set(time.Unix(20, 0))
+	assert.Equal(t, time.Unix(20, 0), frozenClock.Now())
+	frozenClock.Step(-5 * time.Second)
+	assert.Equal(t, time.Unix(15, 0), frozenClock.Now())
+}
+
+func TestFrozenClock_Panics(t *testing.T) {
+	frozenClock := frozenclock.New(time.Unix(0, 0))
+	for _, tt := range []struct {
+		name string
+		f    func()
+	}{
+		{name: "After", f: func() { frozenClock.After(time.Second) }},
+		{name: "AfterFunc", f: func() { frozenClock.AfterFunc(time.Second, func() {}) }},
+		{name: "NewTicker", f: func() { frozenClock.NewTicker(time.Second) }},
+		{name: "NewTimer", f: func() { frozenClock.NewTimer(time.Second) }},
+		{name: "Sleep", f: func() { frozenClock.Sleep(time.Second) }},
+		{name: "Tick", f: func() { frozenClock.Tick(time.Second) }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				msg, ok := recover().(string)
+				assert.Assert(t, ok)
+				assert.Assert(t, strings.HasPrefix(msg, "frozenclock: "+tt.name+"(1s)"), msg)
+			}()
+			tt.f()
+		})
+	}
+}
+
+func TestFrozenClock_NonPositiveDurations(t *testing.T) {
+	frozenClock := frozenclock.New(time.Unix(0, 0))
+	frozenClock.Sleep(0)
+	frozenClock.Sleep(-time.Second)
+	assert.Assert(t, frozenClock.Tick(0) == nil)
+	assert.Equal(t, time.Unix(0, 0), <-frozenClock.After(0))
+	timer := frozenClock.NewTimer(-time.Second)
+	assert.Equal(t, time.Unix(0, 0), <-timer.C())
+	assert.Assert(t, !timer.Stop())
+	frozenClock.Step(time.Second)
+	assert.Assert(t, !timer.Reset(0))
+	assert.Equal(t, time.Unix(1, 0), <-timer.C())
+	called := make(chan struct{})
+	afterFuncTimer := frozenClock.AfterFunc(0, func() { close(called) })
+	<-called
+	assert.Assert(t, afterFuncTimer.C() == nil)
+}
+
+func TestFrozenClock_NewT(t *testing.T) {
+	tb := &recordingTB{}
+	frozenClock := frozenclock.NewT(tb, time.Unix(0, 0))
+	frozenClock.Sleep(time.Second)
+	assert.Equal(t, 1, len(tb.failures))
+	assert.Assert(t, strings.Contains(tb.failures[0], "Sleep(1s)"), tb.failures[0])
+}
+
+type recordingTB struct {
+	failures []string
+}
+
+func (*recordingTB) Helper() {}
+
+func (r *recordingTB) Fatal(args ...any) {
+	r.failures = append(r.failures, args[0].(string))
+}
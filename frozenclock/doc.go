@@ -0,0 +1,7 @@
+// Package frozenclock provides a lightweight clock implementation for unit tests that only read the time.
+//
+// The time of the clock only changes when set or stepped by the test. Since the clock never advances
+// on its own, waiting on it for a positive duration would block forever, so After, AfterFunc, NewTicker,
+// NewTimer, Sleep and Tick fail the test or panic instead. Timers for a negative or zero duration fire
+// immediately. Use externalclock to test code that waits on the clock.
+package frozenclock
@@ -0,0 +1,80 @@
+// Package clockapi defines the interfaces of the clock package, so that packages the clock package
+// depends on can implement them.
+package clockapi
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Clock provides capabilities from the time standard library package.
+type Clock interface {
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(duration time.Duration) <-chan time.Time
+
+	// AfterFunc waits for the duration to elapse and then calls the function f given to it.
+	AfterFunc(d time.Duration, f func()) Timer
+
+	// NewTicker returns a new Ticker.
+	NewTicker(d time.Duration) Ticker
+
+	// NewTimer creates a new Timer that will send the current time on its channel after at least duration d.
+	NewTimer(d time.Duration) Timer
+
+	// Now returns the current local time.
+	Now() time.Time
+
+	// NowProto returns a new Protobuf timestamp representing the current local time.
+	NowProto() *timestamppb.Timestamp
+
+	// Since returns the time elapsed since t.
+	Since(t time.Time) time.Duration
+
+	// Sleep pauses the current goroutine for at least the duration d.
+	// A negative or zero duration causes Sleep to return immediately.
+	Sleep(d time.Duration)
+
+	// Tick is a convenience wrapper for NewTicker providing access to the ticking channel only.
+	// Tick returns nil if d <= 0.
+	Tick(d time.Duration) <-chan time.Time
+
+	// Until returns the duration until t.
+	Until(t time.Time) time.Duration
+}
+
+// Ticker wraps the time.Ticker class.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan time.Time
+
+	// Stop the Ticker.
+	Stop()
+
+	// Reset stops the trigger and next trigger will after the newly given duration has passed.
+	Reset(duration time.Duration)
+}
+
+// Timer wraps the time.Timer class.
+type Timer interface {
+	// C returns the channel on which the timer is going to be triggered.
+	C() <-chan time.Time
+
+	// Stop the Timer.
+	Stop() bool
+
+	// Reset changes the timer to expire after duration d.
+	// It returns true if the timer had been active, false if the timer had expired or been stopped.
+	// No stale value is received on C after Reset returns, following the semantics of Go 1.23.
+	Reset(d time.Duration) bool
+}
+
+// MonotonicClock is a Clock that also provides a monotonic reading of time.
+// Unlike the time returned by Now, the monotonic reading never decreases when the clock is stepped,
+// for example by NTP or when a simulation is rewound, so it is suitable for measuring elapsed time.
+type MonotonicClock interface {
+	Clock
+
+	// Monotonic returns the time elapsed since the clock was created, which never decreases.
+	Monotonic() time.Duration
+}
@@ -0,0 +1,62 @@
+package schedule
+
+import (
+	"time"
+
+	"go.einride.tech/clock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Clock implements the methods of clock.Clock other than Now, using the timers and tickers of a Scheduler
+// and a func returning the current time. Clocks derived from a base clock embed it and define Now.
+type Clock struct {
+	scheduler *Scheduler
+	now       func() time.Time
+}
+
+// NewClock returns a Clock using the timers and tickers of the scheduler, and now for reading the time.
+func NewClock(scheduler *Scheduler, now func() time.Time) Clock {
+	return Clock{scheduler: scheduler, now: now}
+}
+
+func (c Clock) After(d time.Duration) <-chan time.Time {
+	return c.scheduler.NewTimer(d, nil).C()
+}
+
+func (c Clock) AfterFunc(d time.Duration, f func()) clock.Timer {
+	return c.scheduler.NewTimer(d, f)
+}
+
+func (c Clock) NewTicker(d time.Duration) clock.Ticker {
+	return c.scheduler.NewTicker(d)
+}
+
+func (c Clock) NewTimer(d time.Duration) clock.Timer {
+	return c.scheduler.NewTimer(d, nil)
+}
+
+func (c Clock) NowProto() *timestamppb.Timestamp {
+	return timestamppb.New(c.now())
+}
+
+func (c Clock) Since(t time.Time) time.Duration {
+	return c.now().Sub(t)
+}
+
+func (c Clock) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	<-c.After(d)
+}
+
+func (c Clock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		return nil
+	}
+	return c.NewTicker(d).C()
+}
+
+func (c Clock) Until(t time.Time) time.Duration {
+	return t.Sub(c.now())
+}
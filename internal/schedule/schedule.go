@@ -0,0 +1,246 @@
+// Package schedule provides timers and tickers on a timeline derived from a base clock.
+package schedule
+
+import (
+	"sync"
+	"time"
+
+	"go.einride.tech/clock"
+)
+
+// Timeline is a time scale derived from a base clock.
+type Timeline interface {
+	// Now returns the current time of the timeline.
+	Now() time.Time
+
+	// BaseDelay returns how long to wait on the base clock for the timeline to reach t,
+	// or false if the timeline is not advancing.
+	BaseDelay(t time.Time) (time.Duration, bool)
+}
+
+// Scheduler schedules timers and tickers on a Timeline, using timers of the base clock.
+type Scheduler struct {
+	base      clock.Clock
+	timeline  Timeline
+	onDeliver func(c <-chan time.Time, t time.Time)
+	mutex     sync.Mutex
+	pending   map[*entry]struct{}
+}
+
+// Option configures a Scheduler created by New.
+type Option func(*Scheduler)
+
+// WithOnDeliver sets a func called with the channel and the time after each time delivered on the channel
+// of a timer or ticker. It is called without holding any lock of the scheduler.
+func WithOnDeliver(f func(c <-chan time.Time, t time.Time)) Option {
+	return func(s *Scheduler) {
+		s.onDeliver = f
+	}
+}
+
+// New returns a new Scheduler for the timeline derived from the base clock.
+func New(base clock.Clock, timeline Timeline, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		base:     base,
+		timeline: timeline,
+		pending:  map[*entry]struct{}{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Update calls f while no timers fire, and then moves the deadlines of all pending timers and tickers
+// by the duration returned by f. Use it to change the timeline.
+func (s *Scheduler) Update(f func() (shift time.Duration)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	shift := f()
+	for e := range s.pending {
+		e.deadline = e.deadline.Add(shift)
+		e.arm()
+	}
+}
+
+// NewTimer returns a Timer expiring after d on the timeline.
+// When it expires, the Timer calls f if not nil, or else sends the time on its channel.
+func (s *Scheduler) NewTimer(d time.Duration, f func()) *Timer {
+	e := &entry{scheduler: s, f: f}
+	if f == nil {
+		e.c = make(chan time.Time, 1)
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	e.start(d, 0)
+	return &Timer{entry: e}
+}
+
+// NewTicker returns a Ticker ticking every d on the timeline. It panics if d is not positive.
+func (s *Scheduler) NewTicker(d time.Duration) *Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+	e := &entry{scheduler: s, c: make(chan time.Time, 1)}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	e.start(d, d)
+	return &Ticker{entry: e}
+}
+
+// entry is a timer or ticker. Its fields are guarded by the scheduler mutex.
+type entry struct {
+	scheduler *Scheduler
+	c         chan time.Time
+	f         func()
+	deadline  time.Time
+	period    time.Duration
+	baseTimer clock.Timer
+	// generation identifies the current arming of the base timer, so that
+	// base timers firing after having been stopped or replaced are ignored.
+	generation uint64
+}
+
+// start schedules the entry to expire after d, with the period for tickers.
+func (e *entry) start(d, period time.Duration) {
+	e.deadline = e.scheduler.timeline.Now().Add(d)
+	e.period = period
+	e.scheduler.pending[e] = struct{}{}
+	e.arm()
+}
+
+// arm (re)starts the base timer for the deadline of the entry.
+func (e *entry) arm() {
+	e.disarm()
+	delay, ok := e.scheduler.timeline.BaseDelay(e.deadline)
+	if !ok {
+		return
+	}
+	generation := e.generation
+	e.baseTimer = e.scheduler.base.AfterFunc(delay, func() {
+		e.expire(generation)
+	})
+}
+
+// disarm stops the base timer, if any.
+func (e *entry) disarm() {
+	e.generation++
+	if e.baseTimer != nil {
+		e.baseTimer.Stop()
+		e.baseTimer = nil
+	}
+}
+
+// stop stops the entry, discarding any time not yet received, and reports whether it was active.
+func (e *entry) stop() bool {
+	_, pending := e.scheduler.pending[e]
+	delete(e.scheduler.pending, e)
+	e.disarm()
+	return pending || e.drain()
+}
+
+func (e *entry) drain() bool {
+	select {
+	case <-e.c:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e *entry) expire(generation uint64) {
+	s := e.scheduler
+	s.mutex.Lock()
+	if generation != e.generation {
+		s.mutex.Unlock()
+		return
+	}
+	now := s.timeline.Now()
+	if now.Before(e.deadline) {
+		// The timeline has not reached the deadline, for example due to rounding.
+		e.arm()
+		s.mutex.Unlock()
+		return
+	}
+	if e.period > 0 {
+		// Skip the periods missed, like time.Ticker does for slow receivers.
+		e.deadline = e.deadline.Add(e.period * (now.Sub(e.deadline)/e.period + 1))
+		e.arm()
+	} else {
+		delete(s.pending, e)
+		e.baseTimer = nil
+	}
+	if e.f != nil {
+		s.mutex.Unlock()
+		e.f()
+		return
+	}
+	delivered := false
+	select {
+	case e.c <- now:
+		delivered = true
+	default:
+	}
+	s.mutex.Unlock()
+	if delivered && s.onDeliver != nil {
+		s.onDeliver(e.c, now)
+	}
+}
+
+// Timer is a clock.Timer on a Timeline.
+type Timer struct {
+	*entry
+}
+
+var _ clock.Timer = &Timer{}
+
+// C returns the channel on which the time is delivered, or nil for timers calling a func.
+func (t *Timer) C() <-chan time.Time {
+	return t.c
+}
+
+// Stop prevents the Timer from firing, and reports whether it was active.
+func (t *Timer) Stop() bool {
+	t.scheduler.mutex.Lock()
+	defer t.scheduler.mutex.Unlock()
+	return t.stop()
+}
+
+// Reset changes the timer to expire after d, and reports whether it was active.
+func (t *Timer) Reset(d time.Duration) bool {
+	t.scheduler.mutex.Lock()
+	defer t.scheduler.mutex.Unlock()
+	active := t.stop()
+	t.start(d, 0)
+	return active
+}
+
+// Ticker is a clock.Ticker on a Timeline.
+type Ticker struct {
+	*entry
+}
+
+var _ clock.Ticker = &Ticker{}
+
+// C returns the channel on which the ticks are delivered.
+func (t *Ticker) C() <-chan time.Time {
+	return t.c
+}
+
+// Stop turns off the ticker.
+func (t *Ticker) Stop() {
+	t.scheduler.mutex.Lock()
+	defer t.scheduler.mutex.Unlock()
+	t.stop()
+}
+
+// Reset stops the ticker and resets its period to d. It panics if d is not positive.
+func (t *Ticker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("non-positive interval for Ticker.Reset")
+	}
+	t.scheduler.mutex.Lock()
+	defer t.scheduler.mutex.Unlock()
+	t.stop()
+	t.start(d, d)
+}
@@ -0,0 +1,86 @@
+// Package system provides the clock delegating to the time package, shared by the clock package
+// for the fallback of FromContext and by the systemclock package.
+package system
+
+import (
+	"time"
+
+	"go.einride.tech/clock/internal/clockapi"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Clock is a clock delegating to the time package.
+type Clock struct {
+	// start is the time the clock was created, including a monotonic clock reading.
+	start time.Time
+}
+
+// New returns a new clock.
+func New() *Clock {
+	return &Clock{start: time.Now()}
+}
+
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (c *Clock) AfterFunc(d time.Duration, f func()) clockapi.Timer {
+	return Timer{Timer: time.AfterFunc(d, f)}
+}
+
+func (c *Clock) NewTicker(d time.Duration) clockapi.Ticker {
+	return Ticker{Ticker: time.NewTicker(d)}
+}
+
+func (c *Clock) NewTimer(d time.Duration) clockapi.Timer {
+	return Timer{Timer: time.NewTimer(d)}
+}
+
+func (c *Clock) Now() time.Time {
+	return time.Now()
+}
+
+func (c *Clock) NowProto() *timestamppb.Timestamp {
+	return timestamppb.Now()
+}
+
+// Monotonic returns the time elapsed since the clock was created, measured by the monotonic clock of the system.
+func (c *Clock) Monotonic() time.Duration {
+	return time.Since(c.start)
+}
+
+func (c *Clock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (c *Clock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (c *Clock) Tick(d time.Duration) <-chan time.Time {
+	return time.Tick(d)
+}
+
+func (c *Clock) Until(t time.Time) time.Duration {
+	return time.Until(t)
+}
+
+// Ticker is a ticker of the time package.
+type Ticker struct {
+	*time.Ticker
+}
+
+func (t Ticker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+// Timer is a timer of the time package.
+type Timer struct {
+	*time.Timer
+}
+
+func (t Timer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+var _ clockapi.MonotonicClock = &Clock{}
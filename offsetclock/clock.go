@@ -0,0 +1,65 @@
+package offsetclock
+
+import (
+	"sync"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/internal/schedule"
+)
+
+// Clock is a clock offset from another clock.
+type Clock struct {
+	base      clock.Clock
+	mutex     sync.Mutex
+	offset    time.Duration
+	scheduler *schedule.Scheduler
+	schedule.Clock
+}
+
+var _ clock.Clock = &Clock{}
+
+// New returns a new clock that is offset from the base clock.
+func New(base clock.Clock, offset time.Duration) *Clock {
+	c := &Clock{base: base, offset: offset}
+	c.scheduler = schedule.New(base, timeline{clock: c})
+	c.Clock = schedule.NewClock(c.scheduler, c.Now)
+	return c
+}
+
+// Offset returns the current offset from the base clock.
+func (c *Clock) Offset() time.Duration {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.offset
+}
+
+// SetOffset changes the offset from the base clock.
+// Pending timers and tickers keep firing after their durations have elapsed on the base clock.
+func (c *Clock) SetOffset(offset time.Duration) {
+	c.scheduler.Update(func() time.Duration {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		shift := offset - c.offset
+		c.offset = offset
+		return shift
+	})
+}
+
+func (c *Clock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.base.Now().Add(c.offset)
+}
+
+type timeline struct {
+	clock *Clock
+}
+
+func (t timeline) Now() time.Time {
+	return t.clock.Now()
+}
+
+func (t timeline) BaseDelay(deadline time.Time) (time.Duration, bool) {
+	return t.clock.Until(deadline), true
+}
@@ -0,0 +1,135 @@
+package offsetclock_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+	"go.einride.tech/clock/offsetclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestOffsetClock_Now(t *testing.T) {
+	base := externalclock.New(time.Unix(10, 0))
+	offsetClock := offsetclock.New(base, 300*time.Millisecond)
+	assert.Equal(t, time.UnixMilli(10300), offsetClock.Now())
+	assert.Assert(t, offsetClock.NowProto().AsTime().Equal(time.UnixMilli(10300)))
+	assert.Equal(t, 300*time.Millisecond, offsetClock.Since(time.Unix(10, 0)))
+	assert.Equal(t, -300*time.Millisecond, offsetClock.Until(time.Unix(10, 0)))
+	// when changing the offset
+	offsetClock.SetOffset(-time.Second)
+	// then the clock should step
+	assert.Equal(t, -time.Second, offsetClock.Offset())
+	assert.Equal(t, time.Unix(9, 0), offsetClock.Now())
+}
+
+func TestOffsetClock_NewTimer(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	offsetClock := offsetclock.New(base, time.Hour)
+	timer := offsetClock.NewTimer(time.Second)
+	base.Advance(999 * time.Millisecond)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired early")
+	default:
+	}
+	base.Advance(time.Millisecond)
+	// then the value should be the time of the offset clock
+	assert.Equal(t, time.Unix(3601, 0), <-timer.C())
+	assert.Assert(t, !timer.Stop())
+}
+
+func TestOffsetClock_NewTimer_Reset(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	offsetClock := offsetclock.New(base, time.Hour)
+	timer := offsetClock.NewTimer(time.Second)
+	base.Advance(time.Second)
+	// when resetting an expired timer whose value has not been received
+	assert.Assert(t, timer.Reset(time.Second))
+	// then the stale value should have been discarded
+	select {
+	case <-timer.C():
+		t.Fatal("received stale value")
+	default:
+	}
+	base.Advance(time.Second)
+	assert.Equal(t, time.Unix(3602, 0), <-timer.C())
+}
+
+func TestOffsetClock_SetOffset_PendingTimer(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	offsetClock := offsetclock.New(base, 0)
+	timer := offsetClock.NewTimer(time.Second)
+	// when stepping the clock forward past the deadline of a pending timer
+	offsetClock.SetOffset(time.Hour)
+	base.Advance(500 * time.Millisecond)
+	// then the timer should not fire before its duration has elapsed
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired early")
+	default:
+	}
+	base.Advance(500 * time.Millisecond)
+	assert.Equal(t, time.Unix(3601, 0), <-timer.C())
+}
+
+func TestOffsetClock_NewTicker(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	offsetClock := offsetclock.New(base, -time.Second)
+	ticker := offsetClock.NewTicker(time.Second)
+	defer ticker.Stop()
+	for i := 0; i < 3; i++ {
+		base.Advance(time.Second)
+		assert.Equal(t, time.Unix(int64(i), 0), <-ticker.C())
+	}
+	// when the offset changes between ticks
+	offsetClock.SetOffset(time.Second)
+	base.Advance(time.Second)
+	// then the ticker should keep its period
+	assert.Equal(t, time.Unix(5, 0), <-ticker.C())
+	ticker.Stop()
+	base.Advance(time.Second)
+	select {
+	case <-ticker.C():
+		t.Fatal("stopped ticker ticked")
+	default:
+	}
+}
+
+func TestOffsetClock_AfterFunc(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	offsetClock := offsetclock.New(base, time.Minute)
+	called := make(chan time.Time, 1)
+	timer := offsetClock.AfterFunc(time.Second, func() {
+		called <- offsetClock.Now()
+	})
+	assert.Assert(t, timer.C() == nil)
+	base.Advance(time.Second)
+	assert.Equal(t, time.Unix(61, 0), <-called)
+	assert.Assert(t, !timer.Stop())
+	// when stopping a pending callback
+	stopped := offsetClock.AfterFunc(time.Second, func() {
+		t.Error("stopped callback called")
+	})
+	assert.Assert(t, stopped.Stop())
+	base.Advance(time.Second)
+}
+
+func TestOffsetClock_Sleep(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	offsetClock := offsetclock.New(base, time.Minute)
+	done := make(chan struct{})
+	go func() {
+		offsetClock.Sleep(time.Second)
+		close(done)
+	}()
+	for {
+		base.Advance(100 * time.Millisecond)
+		select {
+		case <-done:
+			assert.Assert(t, !base.Now().Before(time.Unix(1, 0)))
+			return
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,6 @@
+// Package offsetclock provides a clock implementation that is offset from another clock.
+//
+// Changing the offset steps the clock, like adjusting a wall clock. Timers and tickers measure
+// elapsed time and are not affected by the change: a timer pending when the offset changes
+// still fires after its duration has elapsed on the underlying clock.
+package offsetclock
@@ -0,0 +1,379 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: einride/clock/v1/time_source_service.proto
+
+package clockv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request message for TimeSourceService.Set.
+type SetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time to set the clock to.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRequest) Reset() {
+	*x = SetRequest{}
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRequest) ProtoMessage() {}
+
+func (x *SetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRequest.ProtoReflect.Descriptor instead.
+func (*SetRequest) Descriptor() ([]byte, []int) {
+	return file_einride_clock_v1_time_source_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Response message for TimeSourceService.Set.
+type SetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time of the clock after the call.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResponse) Reset() {
+	*x = SetResponse{}
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResponse) ProtoMessage() {}
+
+func (x *SetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResponse.ProtoReflect.Descriptor instead.
+func (*SetResponse) Descriptor() ([]byte, []int) {
+	return file_einride_clock_v1_time_source_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Request message for TimeSourceService.Advance.
+type AdvanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The non-negative duration to advance the clock by.
+	Duration      *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvanceRequest) Reset() {
+	*x = AdvanceRequest{}
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvanceRequest) ProtoMessage() {}
+
+func (x *AdvanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvanceRequest.ProtoReflect.Descriptor instead.
+func (*AdvanceRequest) Descriptor() ([]byte, []int) {
+	return file_einride_clock_v1_time_source_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdvanceRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// Response message for TimeSourceService.Advance.
+type AdvanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time of the clock after the call.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvanceResponse) Reset() {
+	*x = AdvanceResponse{}
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvanceResponse) ProtoMessage() {}
+
+func (x *AdvanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvanceResponse.ProtoReflect.Descriptor instead.
+func (*AdvanceResponse) Descriptor() ([]byte, []int) {
+	return file_einride_clock_v1_time_source_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AdvanceResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Request message for TimeSourceService.Watch.
+type WatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_einride_clock_v1_time_source_service_proto_rawDescGZIP(), []int{4}
+}
+
+// Response message for TimeSourceService.Watch.
+type WatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time of the clock.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchResponse) Reset() {
+	*x = WatchResponse{}
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResponse) ProtoMessage() {}
+
+func (x *WatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_einride_clock_v1_time_source_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResponse.ProtoReflect.Descriptor instead.
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return file_einride_clock_v1_time_source_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+var File_einride_clock_v1_time_source_service_proto protoreflect.FileDescriptor
+
+const file_einride_clock_v1_time_source_service_proto_rawDesc = "" +
+	"\n" +
+	"*einride/clock/v1/time_source_service.proto\x12\x10einride.clock.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"<\n" +
+	"\n" +
+	"SetRequest\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"=\n" +
+	"\vSetResponse\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"G\n" +
+	"\x0eAdvanceRequest\x125\n" +
+	"\bduration\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bduration\"A\n" +
+	"\x0fAdvanceResponse\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\x0e\n" +
+	"\fWatchRequest\"?\n" +
+	"\rWatchResponse\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time2\xf3\x01\n" +
+	"\x11TimeSourceService\x12B\n" +
+	"\x03Set\x12\x1c.einride.clock.v1.SetRequest\x1a\x1d.einride.clock.v1.SetResponse\x12N\n" +
+	"\aAdvance\x12 .einride.clock.v1.AdvanceRequest\x1a!.einride.clock.v1.AdvanceResponse\x12J\n" +
+	"\x05Watch\x12\x1e.einride.clock.v1.WatchRequest\x1a\x1f.einride.clock.v1.WatchResponse0\x01B:Z8go.einride.tech/clock/proto/gen/einride/clock/v1;clockv1b\x06proto3"
+
+var (
+	file_einride_clock_v1_time_source_service_proto_rawDescOnce sync.Once
+	file_einride_clock_v1_time_source_service_proto_rawDescData []byte
+)
+
+func file_einride_clock_v1_time_source_service_proto_rawDescGZIP() []byte {
+	file_einride_clock_v1_time_source_service_proto_rawDescOnce.Do(func() {
+		file_einride_clock_v1_time_source_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_einride_clock_v1_time_source_service_proto_rawDesc), len(file_einride_clock_v1_time_source_service_proto_rawDesc)))
+	})
+	return file_einride_clock_v1_time_source_service_proto_rawDescData
+}
+
+var file_einride_clock_v1_time_source_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_einride_clock_v1_time_source_service_proto_goTypes = []any{
+	(*SetRequest)(nil),            // 0: einride.clock.v1.SetRequest
+	(*SetResponse)(nil),           // 1: einride.clock.v1.SetResponse
+	(*AdvanceRequest)(nil),        // 2: einride.clock.v1.AdvanceRequest
+	(*AdvanceResponse)(nil),       // 3: einride.clock.v1.AdvanceResponse
+	(*WatchRequest)(nil),          // 4: einride.clock.v1.WatchRequest
+	(*WatchResponse)(nil),         // 5: einride.clock.v1.WatchResponse
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
+}
+var file_einride_clock_v1_time_source_service_proto_depIdxs = []int32{
+	6, // 0: einride.clock.v1.SetRequest.time:type_name -> google.protobuf.Timestamp
+	6, // 1: einride.clock.v1.SetResponse.time:type_name -> google.protobuf.Timestamp
+	7, // 2: einride.clock.v1.AdvanceRequest.duration:type_name -> google.protobuf.Duration
+	6, // 3: einride.clock.v1.AdvanceResponse.time:type_name -> google.protobuf.Timestamp
+	6, // 4: einride.clock.v1.WatchResponse.time:type_name -> google.protobuf.Timestamp
+	0, // 5: einride.clock.v1.TimeSourceService.Set:input_type -> einride.clock.v1.SetRequest
+	2, // 6: einride.clock.v1.TimeSourceService.Advance:input_type -> einride.clock.v1.AdvanceRequest
+	4, // 7: einride.clock.v1.TimeSourceService.Watch:input_type -> einride.clock.v1.WatchRequest
+	1, // 8: einride.clock.v1.TimeSourceService.Set:output_type -> einride.clock.v1.SetResponse
+	3, // 9: einride.clock.v1.TimeSourceService.Advance:output_type -> einride.clock.v1.AdvanceResponse
+	5, // 10: einride.clock.v1.TimeSourceService.Watch:output_type -> einride.clock.v1.WatchResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_einride_clock_v1_time_source_service_proto_init() }
+func file_einride_clock_v1_time_source_service_proto_init() {
+	if File_einride_clock_v1_time_source_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_einride_clock_v1_time_source_service_proto_rawDesc), len(file_einride_clock_v1_time_source_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_einride_clock_v1_time_source_service_proto_goTypes,
+		DependencyIndexes: file_einride_clock_v1_time_source_service_proto_depIdxs,
+		MessageInfos:      file_einride_clock_v1_time_source_service_proto_msgTypes,
+	}.Build()
+	File_einride_clock_v1_time_source_service_proto = out.File
+	file_einride_clock_v1_time_source_service_proto_goTypes = nil
+	file_einride_clock_v1_time_source_service_proto_depIdxs = nil
+}
@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: einride/clock/v1/time_source_service.proto
+
+package clockv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TimeSourceService_Set_FullMethodName     = "/einride.clock.v1.TimeSourceService/Set"
+	TimeSourceService_Advance_FullMethodName = "/einride.clock.v1.TimeSourceService/Advance"
+	TimeSourceService_Watch_FullMethodName   = "/einride.clock.v1.TimeSourceService/Watch"
+)
+
+// TimeSourceServiceClient is the client API for TimeSourceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// A service for driving a clock from another process, such as a simulator.
+type TimeSourceServiceClient interface {
+	// Set the time of the clock.
+	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	// Advance the clock, firing the timers due along the way in order.
+	Advance(ctx context.Context, in *AdvanceRequest, opts ...grpc.CallOption) (*AdvanceResponse, error)
+	// Watch the time of the clock.
+	// The current time is sent first, followed by the time after every change.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchResponse], error)
+}
+
+type timeSourceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimeSourceServiceClient(cc grpc.ClientConnInterface) TimeSourceServiceClient {
+	return &timeSourceServiceClient{cc}
+}
+
+func (c *timeSourceServiceClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, TimeSourceService_Set_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeSourceServiceClient) Advance(ctx context.Context, in *AdvanceRequest, opts ...grpc.CallOption) (*AdvanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdvanceResponse)
+	err := c.cc.Invoke(ctx, TimeSourceService_Advance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeSourceServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TimeSourceService_ServiceDesc.Streams[0], TimeSourceService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, WatchResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TimeSourceService_WatchClient = grpc.ServerStreamingClient[WatchResponse]
+
+// TimeSourceServiceServer is the server API for TimeSourceService service.
+// All implementations must embed UnimplementedTimeSourceServiceServer
+// for forward compatibility.
+//
+// A service for driving a clock from another process, such as a simulator.
+type TimeSourceServiceServer interface {
+	// Set the time of the clock.
+	Set(context.Context, *SetRequest) (*SetResponse, error)
+	// Advance the clock, firing the timers due along the way in order.
+	Advance(context.Context, *AdvanceRequest) (*AdvanceResponse, error)
+	// Watch the time of the clock.
+	// The current time is sent first, followed by the time after every change.
+	Watch(*WatchRequest, grpc.ServerStreamingServer[WatchResponse]) error
+	mustEmbedUnimplementedTimeSourceServiceServer()
+}
+
+// UnimplementedTimeSourceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimeSourceServiceServer struct{}
+
+func (UnimplementedTimeSourceServiceServer) Set(context.Context, *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedTimeSourceServiceServer) Advance(context.Context, *AdvanceRequest) (*AdvanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Advance not implemented")
+}
+func (UnimplementedTimeSourceServiceServer) Watch(*WatchRequest, grpc.ServerStreamingServer[WatchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedTimeSourceServiceServer) mustEmbedUnimplementedTimeSourceServiceServer() {}
+func (UnimplementedTimeSourceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeTimeSourceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimeSourceServiceServer will
+// result in compilation errors.
+type UnsafeTimeSourceServiceServer interface {
+	mustEmbedUnimplementedTimeSourceServiceServer()
+}
+
+func RegisterTimeSourceServiceServer(s grpc.ServiceRegistrar, srv TimeSourceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTimeSourceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimeSourceService_ServiceDesc, srv)
+}
+
+func _TimeSourceService_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeSourceServiceServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeSourceService_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeSourceServiceServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeSourceService_Advance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdvanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeSourceServiceServer).Advance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeSourceService_Advance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeSourceServiceServer).Advance(ctx, req.(*AdvanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeSourceService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TimeSourceServiceServer).Watch(m, &grpc.GenericServerStream[WatchRequest, WatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TimeSourceService_WatchServer = grpc.ServerStreamingServer[WatchResponse]
+
+// TimeSourceService_ServiceDesc is the grpc.ServiceDesc for TimeSourceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimeSourceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "einride.clock.v1.TimeSourceService",
+	HandlerType: (*TimeSourceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Set",
+			Handler:    _TimeSourceService_Set_Handler,
+		},
+		{
+			MethodName: "Advance",
+			Handler:    _TimeSourceService_Advance_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _TimeSourceService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "einride/clock/v1/time_source_service.proto",
+}
@@ -0,0 +1,10 @@
+// Package recordclock records the interactions of code with a clock, and replays them.
+//
+// A Recorder decorates a clock, writing every call together with its result and caller to a recording,
+// as well as every value delivered by its timers and tickers. A Replayer serves the recorded results back
+// in order, without waiting, and flags when the calls made diverge from the recording. This makes it
+// possible to reproduce a run, for example from the field, in a test.
+//
+// Recordings are in JSON Lines format, with one Entry per line. The format is stable: fields may be
+// added in later versions, but existing fields will keep their names and meanings.
+package recordclock
@@ -0,0 +1,68 @@
+package recordclock
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// Entry is a line of a recording.
+type Entry struct {
+	// Seq numbers the entries of a recording from 1, in the order in which the calls returned
+	// and the values were delivered.
+	Seq uint64 `json:"seq,omitempty"`
+	// Method is the method called, or MethodDeliver for a delivery.
+	Method Method `json:"method"`
+	// Call is the Seq of the call that created the timer or ticker,
+	// for deliveries and calls to methods of timers and tickers.
+	Call uint64 `json:"call,omitempty"`
+	// Caller is the source location of the call, as file:line.
+	Caller string `json:"caller,omitempty"`
+	// Duration is the duration argument of the call, in nanoseconds.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Time is the time returned by Now and NowProto, the time at which Sleep returned,
+	// or the time delivered.
+	Time time.Time `json:"time,omitzero"`
+	// Result is the result of Stop and Reset of timers.
+	Result bool `json:"result,omitempty"`
+}
+
+// Method identifies the method of a recorded call.
+type Method string
+
+const (
+	// MethodNow is a call to Now, Since or Until.
+	MethodNow Method = "Now"
+	// MethodNowProto is a call to NowProto.
+	MethodNowProto Method = "NowProto"
+	// MethodSleep is a call to Sleep.
+	MethodSleep Method = "Sleep"
+	// MethodAfter is a call to After.
+	MethodAfter Method = "After"
+	// MethodAfterFunc is a call to AfterFunc.
+	MethodAfterFunc Method = "AfterFunc"
+	// MethodNewTimer is a call to NewTimer.
+	MethodNewTimer Method = "NewTimer"
+	// MethodNewTicker is a call to NewTicker or Tick.
+	MethodNewTicker Method = "NewTicker"
+	// MethodTimerStop is a call to Stop of a timer.
+	MethodTimerStop Method = "Timer.Stop"
+	// MethodTimerReset is a call to Reset of a timer.
+	MethodTimerReset Method = "Timer.Reset"
+	// MethodTickerStop is a call to Stop of a ticker.
+	MethodTickerStop Method = "Ticker.Stop"
+	// MethodTickerReset is a call to Reset of a ticker.
+	MethodTickerReset Method = "Ticker.Reset"
+	// MethodDeliver is the delivery of a value by a timer or ticker, or the call of the func of AfterFunc.
+	MethodDeliver Method = "Deliver"
+)
+
+// callerLocation returns the source location skip frames up the stack,
+// where a skip of 1 identifies the caller of callerLocation.
+func callerLocation(skip int) string {
+	_, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
@@ -0,0 +1,155 @@
+package recordclock_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/externalclock"
+	"go.einride.tech/clock/recordclock"
+	"gotest.tools/v3/assert"
+)
+
+// workload is an example of code depending on a clock.Clock.
+func workload(c clock.Clock) []time.Time {
+	var result []time.Time
+	start := c.Now()
+	result = append(result, start, c.NowProto().AsTime())
+	c.Sleep(time.Second)
+	result = append(result, <-c.After(time.Second))
+	ticker := c.NewTicker(time.Second)
+	result = append(result, <-ticker.C(), <-ticker.C())
+	ticker.Stop()
+	timer := c.NewTimer(time.Hour)
+	if timer.Stop() {
+		result = append(result, c.Now())
+	}
+	called := make(chan time.Time)
+	c.AfterFunc(time.Second, func() {
+		called <- c.Now()
+	})
+	result = append(result, <-called)
+	return append(result, time.Unix(0, 0).Add(c.Since(start)))
+}
+
+// advanceUntilDone advances the clock in steps until f returns.
+func advanceUntilDone(externalClock *externalclock.Clock, f func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	for {
+		select {
+		case <-done:
+			return
+		case <-time.After(time.Millisecond):
+			externalClock.Advance(100 * time.Millisecond)
+		}
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	externalClock := externalclock.New(time.Unix(1000, 0))
+	var recording bytes.Buffer
+	recorder := recordclock.NewRecorder(externalClock, &recording)
+	var recorded []time.Time
+	advanceUntilDone(externalClock, func() {
+		recorded = workload(recorder)
+	})
+	assert.NilError(t, recorder.Err())
+	// when replaying the recording
+	replayer, err := recordclock.NewReplayer(&recording, recordclock.WithOnDivergence(func(err error) {
+		t.Error(err)
+	}))
+	assert.NilError(t, err)
+	replayed := workload(replayer)
+	// then the same values should be served, without waiting
+	assert.DeepEqual(t, recorded, replayed)
+	assert.NilError(t, replayer.Err())
+	assert.Equal(t, 0, replayer.Remaining())
+}
+
+func TestReplayer_AfterFunc_Order(t *testing.T) {
+	// Given a func called by AfterFunc that reads the clock after the caller of AfterFunc has read it
+	run := func(c clock.Clock, advance func()) []time.Time {
+		called := make(chan time.Time, 1)
+		c.AfterFunc(time.Second, func() {
+			called <- c.Now()
+		})
+		// Yield, so that a func called too early would read the clock first.
+		runtime.Gosched()
+		now := c.Now()
+		advance()
+		return []time.Time{now, <-called}
+	}
+	externalClock := externalclock.New(time.Unix(0, 0))
+	var recording bytes.Buffer
+	recorder := recordclock.NewRecorder(externalClock, &recording)
+	recorded := run(recorder, func() {
+		externalClock.Advance(time.Second)
+	})
+	assert.DeepEqual(t, []time.Time{time.Unix(0, 0), time.Unix(1, 0)}, recorded)
+	// when replaying the recording
+	replayer, err := recordclock.NewReplayer(&recording, recordclock.WithOnDivergence(func(err error) {
+		t.Error(err)
+	}))
+	assert.NilError(t, err)
+	replayed := run(replayer, func() {})
+	// then the func should only be called once the caller has read the clock
+	assert.DeepEqual(t, recorded, replayed)
+	assert.NilError(t, replayer.Err())
+	assert.Equal(t, 0, replayer.Remaining())
+}
+
+func TestReplayer_Divergence(t *testing.T) {
+	recording := strings.Join([]string{
+		`{"seq":1,"method":"Now","caller":"main.go:10","time":"2026-01-02T03:04:05Z"}`,
+		`{"seq":2,"method":"Sleep","caller":"main.go:11","duration":1000000000,"time":"2026-01-02T03:04:06Z"}`,
+	}, "\n")
+	var divergences []error
+	replayer, err := recordclock.NewReplayer(strings.NewReader(recording), recordclock.WithOnDivergence(func(err error) {
+		divergences = append(divergences, err)
+	}))
+	assert.NilError(t, err)
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), replayer.Now())
+	// when the code sleeps for another duration than recorded
+	replayer.Sleep(2 * time.Second)
+	// then the divergence should be flagged
+	assert.Assert(t, errors.Is(replayer.Err(), recordclock.ErrDiverged))
+	assert.ErrorContains(t, replayer.Err(), "recorded Sleep(1s) from main.go:11")
+	assert.Equal(t, 1, len(divergences))
+	// and later calls should serve the last time
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), replayer.Now())
+	assert.Equal(t, 1, replayer.Remaining())
+}
+
+func TestReplayer_EndOfRecording(t *testing.T) {
+	replayer, err := recordclock.NewReplayer(strings.NewReader(""))
+	assert.NilError(t, err)
+	replayer.Now()
+	assert.ErrorContains(t, replayer.Err(), "after end of recording")
+}
+
+func TestRecorder_Format(t *testing.T) {
+	externalClock := externalclock.New(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	var recording bytes.Buffer
+	recorder := recordclock.NewRecorder(externalClock, &recording)
+	_, _, line, _ := runtime.Caller(0)
+	recorder.Now()
+	timer := recorder.NewTimer(time.Second)
+	externalClock.Advance(time.Second)
+	<-timer.C()
+	lines := strings.Split(strings.TrimSpace(recording.String()), "\n")
+	assert.Equal(t, 3, len(lines))
+	assert.Assert(t, strings.HasPrefix(lines[0], `{"seq":1,"method":"Now","caller":"`), lines[0])
+	nowCaller := fmt.Sprintf("recordclock_test.go:%d", line+1)
+	assert.Assert(t, strings.HasSuffix(lines[0], nowCaller+`","time":"2026-01-02T03:04:05Z"}`), lines[0])
+	assert.Assert(t, strings.HasSuffix(lines[1], `","duration":1000000000}`), lines[1])
+	assert.Equal(t, `{"seq":3,"method":"Deliver","call":2,"time":"2026-01-02T03:04:06Z"}`, lines[2])
+}
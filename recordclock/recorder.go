@@ -0,0 +1,231 @@
+package recordclock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/internal/schedule"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Recorder is a clock recording the calls made to it before delegating them to another clock.
+type Recorder struct {
+	base      clock.Clock
+	scheduler *schedule.Scheduler
+	mutex     sync.Mutex
+	encoder   *json.Encoder
+	seq       uint64
+	// channels maps the channels of timers and tickers to the calls that created them,
+	// for recording deliveries.
+	channels map[<-chan time.Time]channelInfo
+	err      error
+}
+
+type channelInfo struct {
+	call     uint64
+	periodic bool
+}
+
+var _ clock.Clock = &Recorder{}
+
+// NewRecorder returns a new Recorder delegating to the base clock and writing the recording to w.
+func NewRecorder(base clock.Clock, w io.Writer) *Recorder {
+	r := &Recorder{
+		base:     base,
+		encoder:  json.NewEncoder(w),
+		channels: map[<-chan time.Time]channelInfo{},
+	}
+	r.scheduler = schedule.New(base, baseTimeline{base: base}, schedule.WithOnDeliver(r.delivered))
+	return r
+}
+
+// Err returns the first error writing the recording, if any.
+// Once writing has failed, calls are still delegated but no longer recorded.
+func (r *Recorder) Err() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.err
+}
+
+// record numbers and writes the entry, and returns its Seq.
+func (r *Recorder) record(e Entry) uint64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.recordLocked(e)
+}
+
+// recordLocked is like record. The caller must hold the mutex.
+func (r *Recorder) recordLocked(e Entry) uint64 {
+	r.seq++
+	e.Seq = r.seq
+	if r.err == nil {
+		if err := r.encoder.Encode(e); err != nil {
+			r.err = fmt.Errorf("record %s: %w", e.Method, err)
+		}
+	}
+	return e.Seq
+}
+
+func (r *Recorder) delivered(c <-chan time.Time, t time.Time) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	info, ok := r.channels[c]
+	if !ok {
+		return
+	}
+	if !info.periodic {
+		delete(r.channels, c)
+	}
+	r.recordLocked(Entry{Method: MethodDeliver, Call: info.call, Time: t})
+}
+
+func (r *Recorder) now(caller string) time.Time {
+	now := r.base.Now()
+	r.record(Entry{Method: MethodNow, Caller: caller, Time: now})
+	return now
+}
+
+func (r *Recorder) After(d time.Duration) <-chan time.Time {
+	return r.newTimer(MethodAfter, d, callerLocation(2)).C()
+}
+
+// AfterFunc records the call, and the time at which the duration has elapsed on the base clock
+// before calling f in its own goroutine.
+func (r *Recorder) AfterFunc(d time.Duration, f func()) clock.Timer {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var call uint64
+	t := r.scheduler.NewTimer(d, func() {
+		now := r.base.Now()
+		// Hold the mutex to read call, which is written after creating the timer.
+		r.mutex.Lock()
+		r.recordLocked(Entry{Method: MethodDeliver, Call: call, Time: now})
+		r.mutex.Unlock()
+		f()
+	})
+	call = r.recordLocked(Entry{Method: MethodAfterFunc, Caller: callerLocation(2), Duration: d})
+	return &recordedTimer{Timer: t, recorder: r, call: call}
+}
+
+func (r *Recorder) NewTicker(d time.Duration) clock.Ticker {
+	return r.newTicker(d, callerLocation(2))
+}
+
+func (r *Recorder) NewTimer(d time.Duration) clock.Timer {
+	return r.newTimer(MethodNewTimer, d, callerLocation(2))
+}
+
+func (r *Recorder) newTimer(method Method, d time.Duration, caller string) *recordedTimer {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	t := r.scheduler.NewTimer(d, nil)
+	call := r.recordLocked(Entry{Method: method, Caller: caller, Duration: d})
+	r.channels[t.C()] = channelInfo{call: call}
+	return &recordedTimer{Timer: t, recorder: r, call: call}
+}
+
+func (r *Recorder) newTicker(d time.Duration, caller string) *recordedTicker {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	t := r.scheduler.NewTicker(d)
+	call := r.recordLocked(Entry{Method: MethodNewTicker, Caller: caller, Duration: d})
+	r.channels[t.C()] = channelInfo{call: call, periodic: true}
+	return &recordedTicker{Ticker: t, recorder: r, call: call}
+}
+
+func (r *Recorder) Now() time.Time {
+	return r.now(callerLocation(2))
+}
+
+func (r *Recorder) NowProto() *timestamppb.Timestamp {
+	now := r.base.NowProto()
+	r.record(Entry{Method: MethodNowProto, Caller: callerLocation(2), Time: now.AsTime()})
+	return now
+}
+
+func (r *Recorder) Since(t time.Time) time.Duration {
+	return r.now(callerLocation(2)).Sub(t)
+}
+
+// Sleep pauses the current goroutine for at least the duration d, and records the call when it returns.
+func (r *Recorder) Sleep(d time.Duration) {
+	r.base.Sleep(d)
+	r.record(Entry{Method: MethodSleep, Caller: callerLocation(2), Duration: d, Time: r.base.Now()})
+}
+
+func (r *Recorder) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		return nil
+	}
+	return r.newTicker(d, callerLocation(2)).C()
+}
+
+func (r *Recorder) Until(t time.Time) time.Duration {
+	return t.Sub(r.now(callerLocation(2)))
+}
+
+type recordedTimer struct {
+	*schedule.Timer
+	recorder *Recorder
+	call     uint64
+}
+
+func (t *recordedTimer) Stop() bool {
+	r := t.recorder
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	result := t.Timer.Stop()
+	r.recordLocked(Entry{Method: MethodTimerStop, Call: t.call, Caller: callerLocation(2), Result: result})
+	return result
+}
+
+func (t *recordedTimer) Reset(d time.Duration) bool {
+	r := t.recorder
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	result := t.Timer.Reset(d)
+	if c := t.C(); c != nil {
+		r.channels[c] = channelInfo{call: t.call}
+	}
+	r.recordLocked(Entry{Method: MethodTimerReset, Call: t.call, Caller: callerLocation(2), Duration: d, Result: result})
+	return result
+}
+
+type recordedTicker struct {
+	*schedule.Ticker
+	recorder *Recorder
+	call     uint64
+}
+
+func (t *recordedTicker) Stop() {
+	r := t.recorder
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	t.Ticker.Stop()
+	r.recordLocked(Entry{Method: MethodTickerStop, Call: t.call, Caller: callerLocation(2)})
+}
+
+func (t *recordedTicker) Reset(d time.Duration) {
+	r := t.recorder
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	t.Ticker.Reset(d)
+	r.recordLocked(Entry{Method: MethodTickerReset, Call: t.call, Caller: callerLocation(2), Duration: d})
+}
+
+// baseTimeline is the timeline of the base clock itself.
+type baseTimeline struct {
+	base clock.Clock
+}
+
+func (t baseTimeline) Now() time.Time {
+	return t.base.Now()
+}
+
+func (t baseTimeline) BaseDelay(deadline time.Time) (time.Duration, bool) {
+	return t.base.Until(deadline), true
+}
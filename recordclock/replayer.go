@@ -0,0 +1,263 @@
+package recordclock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"go.einride.tech/clock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrDiverged is returned by Replayer.Err when the calls made diverged from the recording.
+var ErrDiverged = errors.New("replay diverged from recording")
+
+// Option configures a Replayer created by NewReplayer.
+type Option func(*Replayer)
+
+// WithOnDivergence sets a func called with the error when the calls made diverge from the recording,
+// such as the Error method of a testing.T.
+func WithOnDivergence(f func(err error)) Option {
+	return func(r *Replayer) {
+		r.onDivergence = f
+	}
+}
+
+// Replayer is a clock serving the results of a recording.
+//
+// Each call must match the next call of the recording, by method, duration and, for methods of timers
+// and tickers, the call that created them. The callers are not compared, so that recordings can be replayed
+// after the code has changed. Calls never wait: Sleep returns immediately, and the values recorded as delivered
+// by a timer or ticker are buffered on its channel as soon as it is created, regardless of later calls
+// to Stop and Reset. The func of AfterFunc is called for each recorded delivery, once the calls recorded
+// before the delivery have been made. Once the calls have diverged, no more calls are matched, Now returns
+// the last time served and timers and tickers never fire.
+type Replayer struct {
+	mutex      sync.Mutex
+	calls      []Entry
+	next       int
+	deliveries map[uint64][]Entry
+	// pending holds the funcs of AfterFunc waiting for the calls recorded before their deliveries.
+	pending      []pendingFunc
+	last         time.Time
+	err          error
+	onDivergence func(error)
+}
+
+var _ clock.Clock = &Replayer{}
+
+// NewReplayer returns a new Replayer serving the recording read from r.
+func NewReplayer(r io.Reader, opts ...Option) (*Replayer, error) {
+	replayer := &Replayer{deliveries: map[uint64][]Entry{}}
+	for _, opt := range opts {
+		opt(replayer)
+	}
+	decoder := json.NewDecoder(r)
+	for {
+		var e Entry
+		if err := decoder.Decode(&e); err != nil {
+			if errors.Is(err, io.EOF) {
+				return replayer, nil
+			}
+			return nil, fmt.Errorf("new replayer: %w", err)
+		}
+		if e.Method == MethodDeliver {
+			replayer.deliveries[e.Call] = append(replayer.deliveries[e.Call], e)
+			continue
+		}
+		replayer.calls = append(replayer.calls, e)
+	}
+}
+
+// Err returns an error wrapping ErrDiverged if the calls made have diverged from the recording.
+func (r *Replayer) Err() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.err
+}
+
+// Remaining returns the number of recorded calls not yet made.
+func (r *Replayer) Remaining() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return len(r.calls) - r.next
+}
+
+// expect matches a call with the next call of the recording, and returns the recorded call if it matches.
+func (r *Replayer) expect(method Method, call uint64, d time.Duration, caller string) (Entry, bool) {
+	r.mutex.Lock()
+	if r.err != nil {
+		r.mutex.Unlock()
+		return Entry{}, false
+	}
+	if r.next >= len(r.calls) {
+		r.err = fmt.Errorf("call %s(%v) from %s after end of recording: %w", method, d, caller, ErrDiverged)
+	} else if e := r.calls[r.next]; e.Method != method || e.Duration != d || e.Call != call {
+		r.err = fmt.Errorf(
+			"call %d is %s(%v) from %s, recorded %s(%v) from %s: %w",
+			e.Seq, method, d, caller, e.Method, e.Duration, e.Caller, ErrDiverged,
+		)
+	} else {
+		r.next++
+		if !e.Time.IsZero() {
+			r.last = e.Time
+		}
+		r.releaseLocked()
+		r.mutex.Unlock()
+		return e, true
+	}
+	err := r.err
+	r.mutex.Unlock()
+	if r.onDivergence != nil {
+		r.onDivergence(err)
+	}
+	return Entry{}, false
+}
+
+// releaseLocked starts the pending funcs whose deliveries were recorded before the next call.
+// The caller must hold the mutex.
+func (r *Replayer) releaseLocked() {
+	if r.err != nil {
+		return
+	}
+	pending := r.pending[:0]
+	for _, p := range r.pending {
+		if r.next < len(r.calls) && p.seq > r.calls[r.next].Seq {
+			pending = append(pending, p)
+			continue
+		}
+		go p.f()
+	}
+	r.pending = pending
+}
+
+// channel returns a channel buffering the values recorded as delivered for the call.
+func (r *Replayer) channel(e Entry, ok bool) chan time.Time {
+	if !ok {
+		return make(chan time.Time)
+	}
+	deliveries := r.deliveries[e.Seq]
+	c := make(chan time.Time, max(len(deliveries), 1))
+	for _, d := range deliveries {
+		c <- d.Time
+	}
+	return c
+}
+
+func (r *Replayer) now(caller string) time.Time {
+	if e, ok := r.expect(MethodNow, 0, 0, caller); ok {
+		return e.Time
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.last
+}
+
+func (r *Replayer) After(d time.Duration) <-chan time.Time {
+	return r.channel(r.expect(MethodAfter, 0, d, callerLocation(2)))
+}
+
+// AfterFunc calls f in its own goroutine for each recorded delivery, once the calls recorded before
+// the delivery have been made.
+func (r *Replayer) AfterFunc(d time.Duration, f func()) clock.Timer {
+	e, ok := r.expect(MethodAfterFunc, 0, d, callerLocation(2))
+	if ok {
+		r.mutex.Lock()
+		for _, delivery := range r.deliveries[e.Seq] {
+			r.pending = append(r.pending, pendingFunc{seq: delivery.Seq, f: f})
+		}
+		r.releaseLocked()
+		r.mutex.Unlock()
+	}
+	return &replayTimer{replayer: r, call: e.Seq}
+}
+
+func (r *Replayer) NewTicker(d time.Duration) clock.Ticker {
+	e, ok := r.expect(MethodNewTicker, 0, d, callerLocation(2))
+	return &replayTicker{replayer: r, call: e.Seq, c: r.channel(e, ok)}
+}
+
+func (r *Replayer) NewTimer(d time.Duration) clock.Timer {
+	e, ok := r.expect(MethodNewTimer, 0, d, callerLocation(2))
+	return &replayTimer{replayer: r, call: e.Seq, c: r.channel(e, ok)}
+}
+
+func (r *Replayer) Now() time.Time {
+	return r.now(callerLocation(2))
+}
+
+func (r *Replayer) NowProto() *timestamppb.Timestamp {
+	if e, ok := r.expect(MethodNowProto, 0, 0, callerLocation(2)); ok {
+		return timestamppb.New(e.Time)
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return timestamppb.New(r.last)
+}
+
+func (r *Replayer) Since(t time.Time) time.Duration {
+	return r.now(callerLocation(2)).Sub(t)
+}
+
+// Sleep returns immediately once matched with the recording.
+func (r *Replayer) Sleep(d time.Duration) {
+	r.expect(MethodSleep, 0, d, callerLocation(2))
+}
+
+func (r *Replayer) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		return nil
+	}
+	return r.channel(r.expect(MethodNewTicker, 0, d, callerLocation(2)))
+}
+
+func (r *Replayer) Until(t time.Time) time.Duration {
+	return t.Sub(r.now(callerLocation(2)))
+}
+
+// pendingFunc is a func of AfterFunc waiting for the delivery recorded with seq.
+type pendingFunc struct {
+	seq uint64
+	f   func()
+}
+
+type replayTimer struct {
+	replayer *Replayer
+	call     uint64
+	c        chan time.Time
+}
+
+func (t *replayTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *replayTimer) Stop() bool {
+	e, _ := t.replayer.expect(MethodTimerStop, t.call, 0, callerLocation(2))
+	return e.Result
+}
+
+func (t *replayTimer) Reset(d time.Duration) bool {
+	e, _ := t.replayer.expect(MethodTimerReset, t.call, d, callerLocation(2))
+	return e.Result
+}
+
+type replayTicker struct {
+	replayer *Replayer
+	call     uint64
+	c        chan time.Time
+}
+
+func (t *replayTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *replayTicker) Stop() {
+	t.replayer.expect(MethodTickerStop, t.call, 0, callerLocation(2))
+}
+
+func (t *replayTicker) Reset(d time.Duration) {
+	t.replayer.expect(MethodTickerReset, t.call, d, callerLocation(2))
+}
@@ -0,0 +1,133 @@
+package scaledclock
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/internal/schedule"
+)
+
+// Clock is a clock running at a multiple of the rate of another clock.
+type Clock struct {
+	base  clock.Clock
+	mutex sync.Mutex
+	// anchor is the time of the clock when the base clock was at baseAnchor.
+	anchor     time.Time
+	baseAnchor time.Time
+	rate       float64
+	paused     bool
+	scheduler  *schedule.Scheduler
+	schedule.Clock
+}
+
+var _ clock.Clock = &Clock{}
+
+// New returns a new clock starting at the start time and running at the rate of the base clock
+// multiplied by rate. It panics if the rate is not positive.
+func New(base clock.Clock, start time.Time, rate float64) *Clock {
+	checkRate(rate)
+	c := &Clock{
+		base:       base,
+		anchor:     start,
+		baseAnchor: base.Now(),
+		rate:       rate,
+	}
+	c.scheduler = schedule.New(base, timeline{clock: c})
+	c.Clock = schedule.NewClock(c.scheduler, c.Now)
+	return c
+}
+
+// Rate returns the rate of the clock relative to the base clock.
+func (c *Clock) Rate() float64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.rate
+}
+
+// SetRate changes the rate of the clock relative to the base clock. It panics if the rate is not positive.
+// A paused clock remains paused, and runs at the new rate when resumed.
+func (c *Clock) SetRate(rate float64) {
+	checkRate(rate)
+	c.update(func() {
+		c.rate = rate
+	})
+}
+
+// Pause stops the clock until Resume is called.
+func (c *Clock) Pause() {
+	c.update(func() {
+		c.paused = true
+	})
+}
+
+// Resume restarts a paused clock from the time at which it was paused.
+func (c *Clock) Resume() {
+	c.update(func() {
+		c.paused = false
+	})
+}
+
+// Paused reports whether the clock is paused.
+func (c *Clock) Paused() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.paused
+}
+
+// update re-anchors the clock at the current time, calls f and reschedules the pending timers and tickers.
+func (c *Clock) update(f func()) {
+	c.scheduler.Update(func() time.Duration {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		baseNow := c.base.Now()
+		c.anchor = c.now(baseNow)
+		c.baseAnchor = baseNow
+		f()
+		return 0
+	})
+}
+
+// now returns the time of the clock when the base clock is at baseNow.
+// The caller must hold the mutex.
+func (c *Clock) now(baseNow time.Time) time.Time {
+	if c.paused {
+		return c.anchor
+	}
+	return c.anchor.Add(time.Duration(float64(baseNow.Sub(c.baseAnchor)) * c.rate))
+}
+
+func (c *Clock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now(c.base.Now())
+}
+
+func checkRate(rate float64) {
+	if !(rate > 0) || math.IsInf(rate, 1) {
+		panic("non-positive or infinite rate for scaled clock")
+	}
+}
+
+type timeline struct {
+	clock *Clock
+}
+
+func (t timeline) Now() time.Time {
+	return t.clock.Now()
+}
+
+func (t timeline) BaseDelay(deadline time.Time) (time.Duration, bool) {
+	c := t.clock
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.paused {
+		return 0, false
+	}
+	remaining := deadline.Sub(c.now(c.base.Now()))
+	if remaining <= 0 {
+		return 0, true
+	}
+	return time.Duration(math.Ceil(float64(remaining) / c.rate)), true
+}
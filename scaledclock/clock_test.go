@@ -0,0 +1,122 @@
+package scaledclock_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+	"go.einride.tech/clock/scaledclock"
+	"gotest.tools/v3/assert"
+)
+
+func TestScaledClock_Now(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	scaledClock := scaledclock.New(base, time.Unix(100, 0), 10)
+	base.Advance(time.Second)
+	assert.Equal(t, time.Unix(110, 0), scaledClock.Now())
+	// when slowing the clock down
+	scaledClock.SetRate(0.5)
+	base.Advance(time.Second)
+	assert.Equal(t, 0.5, scaledClock.Rate())
+	assert.Equal(t, time.UnixMilli(110500), scaledClock.Now())
+	assert.Assert(t, scaledClock.NowProto().AsTime().Equal(time.UnixMilli(110500)))
+}
+
+func TestScaledClock_PauseResume(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	scaledClock := scaledclock.New(base, time.Unix(0, 0), 2)
+	base.Advance(time.Second)
+	scaledClock.Pause()
+	assert.Assert(t, scaledClock.Paused())
+	base.Advance(time.Hour)
+	assert.Equal(t, time.Unix(2, 0), scaledClock.Now())
+	// when resuming the clock
+	scaledClock.Resume()
+	base.Advance(time.Second)
+	// then it should continue from where it was paused
+	assert.Assert(t, !scaledClock.Paused())
+	assert.Equal(t, time.Unix(4, 0), scaledClock.Now())
+}
+
+func TestScaledClock_NewTimer(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	scaledClock := scaledclock.New(base, time.Unix(0, 0), 10)
+	timer := scaledClock.NewTimer(10 * time.Second)
+	base.Advance(999 * time.Millisecond)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired early")
+	default:
+	}
+	base.Advance(time.Millisecond)
+	assert.Equal(t, time.Unix(10, 0), <-timer.C())
+}
+
+func TestScaledClock_SetRate_PendingTimer(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	scaledClock := scaledclock.New(base, time.Unix(0, 0), 1)
+	timer := scaledClock.NewTimer(10 * time.Second)
+	base.Advance(5 * time.Second)
+	// when speeding the clock up while the timer is pending
+	scaledClock.SetRate(5)
+	base.Advance(time.Second)
+	// then the timer should fire on the scaled timeline
+	assert.Equal(t, time.Unix(10, 0), <-timer.C())
+}
+
+func TestScaledClock_Pause_PendingTimer(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	scaledClock := scaledclock.New(base, time.Unix(0, 0), 1)
+	timer := scaledClock.NewTimer(2 * time.Second)
+	base.Advance(time.Second)
+	scaledClock.Pause()
+	base.Advance(time.Hour)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired while paused")
+	default:
+	}
+	scaledClock.Resume()
+	base.Advance(time.Second)
+	assert.Equal(t, time.Unix(2, 0), <-timer.C())
+}
+
+func TestScaledClock_NewTicker(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	scaledClock := scaledclock.New(base, time.Unix(0, 0), 0.5)
+	ticker := scaledClock.NewTicker(time.Second)
+	defer ticker.Stop()
+	for i := 1; i <= 3; i++ {
+		base.Advance(2 * time.Second)
+		assert.Equal(t, time.Unix(int64(i), 0), <-ticker.C())
+	}
+	scaledClock.SetRate(2)
+	base.Advance(500 * time.Millisecond)
+	assert.Equal(t, time.Unix(4, 0), <-ticker.C())
+}
+
+func TestScaledClock_Sleep(t *testing.T) {
+	base := externalclock.New(time.Unix(0, 0))
+	scaledClock := scaledclock.New(base, time.Unix(0, 0), 100)
+	done := make(chan struct{})
+	go func() {
+		scaledClock.Sleep(time.Minute)
+		close(done)
+	}()
+	for {
+		base.Advance(100 * time.Millisecond)
+		select {
+		case <-done:
+			assert.Assert(t, !scaledClock.Now().Before(time.Unix(60, 0)))
+			return
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestScaledClock_New_NonPositiveRate(t *testing.T) {
+	defer func() {
+		assert.Assert(t, recover() != nil)
+	}()
+	scaledclock.New(externalclock.New(time.Unix(0, 0)), time.Unix(0, 0), 0)
+}
@@ -0,0 +1,7 @@
+// Package scaledclock provides a clock implementation that runs at a multiple of the rate of another clock,
+// for running simulations faster or slower than real time.
+//
+// The rate can be changed, and the clock paused and resumed, at any time. Timers and tickers
+// measure time on the scaled timeline, so a pending timer fires earlier when the rate increases,
+// later when it decreases, and not at all while the clock is paused.
+package scaledclock
@@ -1,2 +1,6 @@
 // Package systemclock provides a clock implementation for the system clock.
+//
+// Since the clock delegates to the time package, code using it can be tested with fake time
+// in a testing/synctest bubble, where it behaves exactly like it does in real time,
+// without waiting for the time to pass.
 package systemclock
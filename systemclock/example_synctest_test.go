@@ -0,0 +1,46 @@
+//go:build go1.25
+
+package systemclock_test
+
+import (
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"go.einride.tech/clock/systemclock"
+)
+
+// This example tests code using the system clock with fake time. Within the testing/synctest bubble,
+// sleeping takes no real time and the clock advances by exactly the duration slept.
+// Since synctest.Test needs the *testing.T of a test, the example is not run on its own.
+func ExampleNew_synctest() {
+	testSleep := func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			c := systemclock.New()
+			start := c.Now()
+			c.Sleep(time.Hour)
+			if elapsed := c.Since(start); elapsed != time.Hour {
+				t.Errorf("slept %v, want 1h", elapsed)
+			}
+		})
+	}
+	_ = testSleep
+}
+
+// This example tests code polling with a ticker of the system clock. Within the testing/synctest bubble,
+// the ticker ticks at exact intervals of fake time, so the number of polls is deterministic.
+func ExampleNew_synctestTicker() {
+	testPoll := func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			c := systemclock.New()
+			deadline := c.Now().Add(10 * time.Second)
+			polls := pollUntil(c, time.Second, func() bool {
+				return !c.Now().Before(deadline)
+			})
+			if polls != 11 {
+				t.Errorf("polled %d times, want 11", polls)
+			}
+		})
+	}
+	_ = testPoll
+}
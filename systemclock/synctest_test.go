@@ -0,0 +1,85 @@
+//go:build go1.25
+
+package systemclock_test
+
+import (
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/systemclock"
+	"gotest.tools/v3/assert"
+)
+
+// pollUntil is an example of code depending on a clock.Clock.
+// It polls f every interval until it returns true, and returns the number of polls.
+func pollUntil(c clock.Clock, interval time.Duration, f func() bool) int {
+	ticker := c.NewTicker(interval)
+	defer ticker.Stop()
+	polls := 1
+	for !f() {
+		<-ticker.C()
+		polls++
+	}
+	return polls
+}
+
+func TestSystemClock_Synctest(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := systemclock.New()
+		// Time in a bubble starts at midnight UTC 2000-01-01.
+		start := c.Now()
+		assert.Equal(t, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), start.UTC())
+		// Sleeping advances the fake time exactly, without waiting.
+		c.Sleep(time.Hour)
+		assert.Equal(t, time.Hour, c.Since(start))
+		assert.Equal(t, -time.Hour, c.Until(start))
+		// Tickers tick at exact intervals.
+		deadline := c.Now().Add(10 * time.Second)
+		polls := pollUntil(c, time.Second, func() bool {
+			return !c.Now().Before(deadline)
+		})
+		assert.Equal(t, 11, polls)
+		assert.Equal(t, time.Hour+10*time.Second, c.Since(start))
+	})
+}
+
+func TestSystemClock_Synctest_Timers(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := systemclock.New()
+		start := c.Now()
+		// AfterFunc runs on expiry.
+		var calledAfter time.Duration
+		c.AfterFunc(time.Second, func() {
+			calledAfter = c.Since(start)
+		})
+		// A stopped timer never fires, and Stop reports it.
+		stopped := c.NewTimer(time.Second)
+		assert.Assert(t, stopped.Stop())
+		// A reset timer fires at its new deadline.
+		timer := c.NewTimer(time.Second)
+		assert.Assert(t, timer.Reset(2*time.Second))
+		assert.Equal(t, start.Add(2*time.Second), <-timer.C())
+		// Wait for the AfterFunc goroutine to be done.
+		synctest.Wait()
+		assert.Equal(t, time.Second, calledAfter)
+		select {
+		case <-stopped.C():
+			t.Fatal("stopped timer fired")
+		default:
+		}
+		// After delivers the time at expiry.
+		assert.Equal(t, start.Add(3*time.Second), <-c.After(time.Second))
+	})
+}
+
+func TestSystemClock_Synctest_Monotonic(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c, ok := systemclock.New().(clock.MonotonicClock)
+		assert.Assert(t, ok)
+		assert.Equal(t, time.Duration(0), c.Monotonic())
+		c.Sleep(time.Minute)
+		assert.Equal(t, time.Minute, c.Monotonic())
+	})
+}
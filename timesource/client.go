@@ -0,0 +1,151 @@
+package timesource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.einride.tech/clock"
+	"go.einride.tech/clock/externalclock"
+	clockv1 "go.einride.tech/clock/proto/gen/einride/clock/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Client is a clock following the time of a remote TimeSourceService.
+//
+// The time of the remote clock is watched and applied to a local externalclock.Clock,
+// whose timers and tickers fire in order as the remote time advances.
+type Client struct {
+	service clockv1.TimeSourceServiceClient
+	clock   *externalclock.Clock
+	cancel  context.CancelFunc
+	done    chan struct{}
+	err     error
+}
+
+var _ clock.Clock = &Client{}
+
+// NewClient returns a new Client following the time of the TimeSourceService served on the connection.
+// It blocks until the current time has been received. The client follows the remote time until ctx is done
+// or Close is called. The options configure the local clock.
+func NewClient(ctx context.Context, conn grpc.ClientConnInterface, opts ...externalclock.Option) (*Client, error) {
+	service := clockv1.NewTimeSourceServiceClient(conn)
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := service.Watch(ctx, &clockv1.WatchRequest{})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("new time source client: %w", err)
+	}
+	response, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("new time source client: %w", err)
+	}
+	c := &Client{
+		service: service,
+		clock:   externalclock.New(response.GetTime().AsTime(), opts...),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go c.follow(stream)
+	return c, nil
+}
+
+func (c *Client) follow(stream grpc.ServerStreamingClient[clockv1.WatchResponse]) {
+	defer close(c.done)
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if status.Code(err) != codes.Canceled {
+				c.err = fmt.Errorf("watch time source: %w", err)
+			}
+			return
+		}
+		if t := response.GetTime().AsTime(); t.Before(c.clock.Now()) {
+			c.clock.SetTimestamp(t)
+		} else {
+			// Don't wait for AfterFunc callbacks, which may themselves wait on the clock.
+			c.clock.AdvanceToWaiting(t, func() {})
+		}
+	}
+}
+
+// Close stops following the remote time and returns the error that ended the watch, if any.
+func (c *Client) Close() error {
+	c.cancel()
+	<-c.done
+	return c.err
+}
+
+// Done returns a channel that is closed when the client has stopped following the remote time.
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Set sets the time of the remote clock and returns it after the call.
+// The local time follows once the change has been watched.
+func (c *Client) Set(ctx context.Context, t time.Time) (time.Time, error) {
+	response, err := c.service.Set(ctx, &clockv1.SetRequest{Time: timestamppb.New(t)})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("set time source: %w", err)
+	}
+	return response.GetTime().AsTime(), nil
+}
+
+// Advance advances the remote clock by d and returns its time after the call.
+// The local time follows once the change has been watched.
+func (c *Client) Advance(ctx context.Context, d time.Duration) (time.Time, error) {
+	if d < 0 {
+		return time.Time{}, errors.New("advance time source: negative duration")
+	}
+	response, err := c.service.Advance(ctx, &clockv1.AdvanceRequest{Duration: durationpb.New(d)})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("advance time source: %w", err)
+	}
+	return response.GetTime().AsTime(), nil
+}
+
+func (c *Client) After(d time.Duration) <-chan time.Time {
+	return c.clock.After(d)
+}
+
+func (c *Client) AfterFunc(d time.Duration, f func()) clock.Timer {
+	return c.clock.AfterFunc(d, f)
+}
+
+func (c *Client) NewTicker(d time.Duration) clock.Ticker {
+	return c.clock.NewTicker(d)
+}
+
+func (c *Client) NewTimer(d time.Duration) clock.Timer {
+	return c.clock.NewTimer(d)
+}
+
+func (c *Client) Now() time.Time {
+	return c.clock.Now()
+}
+
+func (c *Client) NowProto() *timestamppb.Timestamp {
+	return c.clock.NowProto()
+}
+
+func (c *Client) Since(t time.Time) time.Duration {
+	return c.clock.Since(t)
+}
+
+func (c *Client) Sleep(d time.Duration) {
+	c.clock.Sleep(d)
+}
+
+func (c *Client) Tick(d time.Duration) <-chan time.Time {
+	return c.clock.Tick(d)
+}
+
+func (c *Client) Until(t time.Time) time.Duration {
+	return c.clock.Until(t)
+}
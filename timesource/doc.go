@@ -0,0 +1,6 @@
+// Package timesource provides a gRPC service for driving an externalclock.Clock from another process.
+//
+// A process owning the time, such as a simulator, serves the einride.clock.v1.TimeSourceService with a Server,
+// and processes following the time use a Client, which implements clock.Clock. The Client can also set and
+// advance the time of the Server.
+package timesource
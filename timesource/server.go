@@ -0,0 +1,89 @@
+package timesource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+	clockv1 "go.einride.tech/clock/proto/gen/einride/clock/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements the TimeSourceService for an externalclock.Clock.
+// Watchers are notified of every change of the time of the clock, whether made through the Server or not.
+type Server struct {
+	clockv1.UnimplementedTimeSourceServiceServer
+	clock *externalclock.Clock
+	mutex sync.Mutex
+	// changed is closed and replaced whenever the time of the clock changes.
+	changed chan struct{}
+}
+
+var _ clockv1.TimeSourceServiceServer = &Server{}
+
+// NewServer returns a new Server driving the clock.
+func NewServer(c *externalclock.Clock) *Server {
+	s := &Server{clock: c, changed: make(chan struct{})}
+	c.OnChange(func(time.Time) {
+		s.notify()
+	})
+	return s
+}
+
+// Set implements clockv1.TimeSourceServiceServer.
+func (s *Server) Set(_ context.Context, request *clockv1.SetRequest) (*clockv1.SetResponse, error) {
+	if err := request.GetTime().CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time: %v", err)
+	}
+	err := s.clock.TrySetTimestamp(request.GetTime().AsTime())
+	if errors.Is(err, externalclock.ErrTimeMovedBackwards) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &clockv1.SetResponse{Time: s.clock.NowProto()}, nil
+}
+
+// Advance implements clockv1.TimeSourceServiceServer.
+func (s *Server) Advance(_ context.Context, request *clockv1.AdvanceRequest) (*clockv1.AdvanceResponse, error) {
+	if err := request.GetDuration().CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid duration: %v", err)
+	}
+	d := request.GetDuration().AsDuration()
+	if d < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative duration: %v", d)
+	}
+	s.clock.Advance(d)
+	return &clockv1.AdvanceResponse{Time: s.clock.NowProto()}, nil
+}
+
+// Watch implements clockv1.TimeSourceServiceServer.
+// Changes in quick succession may be coalesced, so that only the latest time is sent.
+func (s *Server) Watch(_ *clockv1.WatchRequest, stream clockv1.TimeSourceService_WatchServer) error {
+	var sent *timestamppb.Timestamp
+	for {
+		s.mutex.Lock()
+		changed := s.changed
+		s.mutex.Unlock()
+		if now := s.clock.NowProto(); sent == nil || !now.AsTime().Equal(sent.AsTime()) {
+			if err := stream.Send(&clockv1.WatchResponse{Time: now}); err != nil {
+				return err
+			}
+			sent = now
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-changed:
+		}
+	}
+}
+
+func (s *Server) notify() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
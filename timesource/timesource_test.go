@@ -0,0 +1,124 @@
+package timesource_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"go.einride.tech/clock/externalclock"
+	clockv1 "go.einride.tech/clock/proto/gen/einride/clock/v1"
+	"go.einride.tech/clock/timesource"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gotest.tools/v3/assert"
+)
+
+func TestTimeSource(t *testing.T) {
+	serverClock, client := newTestFixture(t)
+	ctx := context.Background()
+	assert.Equal(t, time.Unix(0, 0).UTC(), client.Now().UTC())
+	// Given a timer on the client
+	timer := client.NewTimer(time.Second)
+	// when advancing the remote clock
+	now, err := client.Advance(ctx, time.Second)
+	assert.NilError(t, err)
+	assert.Equal(t, time.Unix(1, 0).UTC(), now.UTC())
+	assert.Equal(t, time.Unix(1, 0), serverClock.Now())
+	// then the timer should fire when the client has followed
+	assert.Equal(t, time.Unix(1, 0).UTC(), (<-timer.C()).UTC())
+	// when setting the remote clock
+	ticker := client.NewTicker(time.Second)
+	defer ticker.Stop()
+	timer = client.NewTimer(9 * time.Second)
+	now, err = client.Set(ctx, time.Unix(10, 0))
+	assert.NilError(t, err)
+	assert.Equal(t, time.Unix(10, 0).UTC(), now.UTC())
+	// then the client should step through the deadlines on the way
+	assert.Equal(t, time.Unix(2, 0).UTC(), (<-ticker.C()).UTC())
+	assert.Equal(t, time.Unix(10, 0).UTC(), (<-timer.C()).UTC())
+	assert.Equal(t, time.Unix(10, 0).UTC(), client.Now().UTC())
+}
+
+func TestTimeSource_ServerClockChanged(t *testing.T) {
+	serverClock, client := newTestFixture(t)
+	// Given timers on the client
+	first := client.NewTimer(time.Second)
+	second := client.NewTimer(2 * time.Second)
+	// when advancing the clock of the server directly
+	serverClock.Advance(3 * time.Second)
+	// then the client should follow, firing the timers at their deadlines
+	assert.Equal(t, time.Unix(1, 0).UTC(), (<-first.C()).UTC())
+	assert.Equal(t, time.Unix(2, 0).UTC(), (<-second.C()).UTC())
+}
+
+func TestTimeSource_AfterFuncWaitingOnClient(t *testing.T) {
+	serverClock, client := newTestFixture(t)
+	// Given a callback waiting on the client clock
+	slept := make(chan struct{})
+	client.AfterFunc(time.Second, func() {
+		client.Sleep(time.Second)
+		close(slept)
+	})
+	timer := client.NewTimer(3 * time.Second)
+	// when advancing the remote clock past the callback
+	serverClock.Advance(3 * time.Second)
+	// then the client should keep following the remote time
+	assert.Equal(t, time.Unix(3, 0).UTC(), (<-timer.C()).UTC())
+	serverClock.Advance(2 * time.Second)
+	<-slept
+	assert.NilError(t, client.Close())
+}
+
+func TestTimeSource_Errors(t *testing.T) {
+	_, client := newTestFixture(t, externalclock.WithBackwardsPolicy(externalclock.BackwardsReject))
+	ctx := context.Background()
+	_, err := client.Set(ctx, time.Unix(-1, 0))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = client.Advance(ctx, -time.Second)
+	assert.ErrorContains(t, err, "negative duration")
+}
+
+func TestServer_Advance_InvalidDuration(t *testing.T) {
+	server := timesource.NewServer(externalclock.New(time.Unix(0, 0)))
+	_, err := server.Advance(context.Background(), &clockv1.AdvanceRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestClient_Close(t *testing.T) {
+	_, client := newTestFixture(t)
+	assert.NilError(t, client.Close())
+	<-client.Done()
+}
+
+func newTestFixture(t *testing.T, opts ...externalclock.Option) (*externalclock.Clock, *timesource.Client) {
+	t.Helper()
+	serverClock := externalclock.New(time.Unix(0, 0), opts...)
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	clockv1.RegisterTimeSourceServiceServer(grpcServer, timesource.NewServer(serverClock))
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NilError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	client, err := timesource.NewClient(context.Background(), conn)
+	assert.NilError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return serverClock, client
+}